@@ -0,0 +1,201 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Aggregate describes the aggregation functions computed by the server and the fields used to group the items. Each
+// function lists the fields it is applied to. Use "*" with Count to count the items themselves.
+type Aggregate struct {
+	Count         []string
+	CountDistinct []string
+	Sum           []string
+	SumDistinct   []string
+	Avg           []string
+	AvgDistinct   []string
+	Min           []string
+	Max           []string
+
+	GroupBy []string
+}
+
+func (agg Aggregate) functions() map[string][]string {
+	return map[string][]string{
+		"count":         agg.Count,
+		"countDistinct": agg.CountDistinct,
+		"sum":           agg.Sum,
+		"sumDistinct":   agg.SumDistinct,
+		"avg":           agg.Avg,
+		"avgDistinct":   agg.AvgDistinct,
+		"min":           agg.Min,
+		"max":           agg.Max,
+	}
+}
+
+// AggregateResult contains the values computed for a group of items. Each function has the value for every field
+// it was applied to.
+type AggregateResult struct {
+	// Group has the value of the fields used to group the items.
+	Group map[string]any
+
+	Count         map[string]AggregateValue
+	CountDistinct map[string]AggregateValue
+	Sum           map[string]AggregateValue
+	SumDistinct   map[string]AggregateValue
+	Avg           map[string]AggregateValue
+	AvgDistinct   map[string]AggregateValue
+	Min           map[string]AggregateValue
+	Max           map[string]AggregateValue
+}
+
+// AggregateValue is a value computed by the server. Depending on the database it is returned as a number or as a
+// string, so it keeps the text representation to parse it as needed.
+type AggregateValue string
+
+// IsNull reports if the server did not compute a value, for example the average of an empty group.
+func (value AggregateValue) IsNull() bool {
+	return value == ""
+}
+
+// Int64 parses the value as an integer.
+func (value AggregateValue) Int64() (int64, error) {
+	n, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("directus: cannot parse aggregate value %q as integer: %w", string(value), err)
+	}
+	return n, nil
+}
+
+// Float64 parses the value as a floating point number.
+func (value AggregateValue) Float64() (float64, error) {
+	n, err := strconv.ParseFloat(string(value), 64)
+	if err != nil {
+		return 0, fmt.Errorf("directus: cannot parse aggregate value %q as number: %w", string(value), err)
+	}
+	return n, nil
+}
+
+func (value *AggregateValue) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*value = ""
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*value = AggregateValue(str)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("directus: unexpected aggregate value %s", string(data))
+	}
+	*value = AggregateValue(n.String())
+	return nil
+}
+
+func (result *AggregateResult) values(fn string) *map[string]AggregateValue {
+	switch fn {
+	case "count":
+		return &result.Count
+	case "countDistinct":
+		return &result.CountDistinct
+	case "sum":
+		return &result.Sum
+	case "sumDistinct":
+		return &result.SumDistinct
+	case "avg":
+		return &result.Avg
+	case "avgDistinct":
+		return &result.AvgDistinct
+	case "min":
+		return &result.Min
+	case "max":
+		return &result.Max
+	}
+	return nil
+}
+
+func (result *AggregateResult) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for key, value := range raw {
+		dest := result.values(key)
+		if dest == nil {
+			if result.Group == nil {
+				result.Group = make(map[string]any)
+			}
+			var group any
+			if err := json.Unmarshal(value, &group); err != nil {
+				return err
+			}
+			result.Group[key] = group
+			continue
+		}
+
+		// Counting items with "*" returns a single value instead of an object with the fields.
+		*dest = make(map[string]AggregateValue)
+		if strings.HasPrefix(strings.TrimSpace(string(value)), "{") {
+			if err := json.Unmarshal(value, dest); err != nil {
+				return err
+			}
+		} else {
+			var single AggregateValue
+			if err := json.Unmarshal(value, &single); err != nil {
+				return err
+			}
+			(*dest)["*"] = single
+		}
+	}
+	return nil
+}
+
+// Aggregate computes the aggregation functions in the server over the items that match the filter, grouping them if
+// requested. The filter can be nil. Directus limits the number of groups returned like any other list, use
+// WithLimit(-1) to obtain all of them.
+func (items *ItemsClient[T]) Aggregate(ctx context.Context, filter Filter, agg Aggregate, opts ...ReadOption) ([]*AggregateResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, items.c.urlf("/items/%s", items.collection), nil)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	if err := items.applyOpts(req, opts...); err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	if !isNoopFilter(filter) {
+		f, err := FilterJSON(filter)
+		if err != nil {
+			return nil, err
+		}
+		q.Set("filter", f)
+	}
+	var empty = true
+	for fn, fields := range agg.functions() {
+		if len(fields) > 0 {
+			empty = false
+			q.Set(fmt.Sprintf("aggregate[%s]", fn), strings.Join(fields, ","))
+		}
+	}
+	if empty {
+		return nil, fmt.Errorf("directus: aggregate without functions")
+	}
+	for _, field := range agg.GroupBy {
+		q.Add("groupBy[]", field)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	reply := struct {
+		Data []*AggregateResult `json:"data"`
+	}{}
+	if err := items.c.sendRequest(req, &reply); err != nil {
+		return nil, err
+	}
+	return reply.Data, nil
+}
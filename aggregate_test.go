@@ -0,0 +1,83 @@
+package directus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsAggregate(t *testing.T) {
+	var query string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, _ = url.QueryUnescape(r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+			{
+				"data": [
+					{"category": "books", "count": {"id": 3}, "sum": {"price": "45.50"}, "avg": {"price": 15.1666}},
+					{"category": "games", "count": {"id": 1}, "sum": {"price": "60.00"}, "avg": {"price": null}}
+				]
+			}
+		`)
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "products")
+
+	results, err := items.Aggregate(context.Background(), Eq("status", "published"), Aggregate{
+		Count:   []string{"id"},
+		Sum:     []string{"price"},
+		Avg:     []string{"price"},
+		GroupBy: []string{"category"},
+	}, WithSearch("foo"), WithLimit(-1))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	require.Equal(t, "books", results[0].Group["category"])
+	count, err := results[0].Count["id"].Int64()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, count)
+	sum, err := results[0].Sum["price"].Float64()
+	require.NoError(t, err)
+	require.Equal(t, 45.5, sum)
+	require.Equal(t, AggregateValue("15.1666"), results[0].Avg["price"])
+	require.True(t, results[1].Avg["price"].IsNull())
+
+	require.Equal(t, `aggregate[avg]=price&aggregate[count]=id&aggregate[sum]=price&filter={"status":{"_eq":"published"}}`+"\n"+`&groupBy[]=category&limit=-1&search=foo`, query)
+}
+
+func TestItemsAggregateCountAll(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"data": [{"count": "42"}]}`)
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "products")
+
+	results, err := items.Aggregate(context.Background(), nil, Aggregate{Count: []string{"*"}})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Empty(t, results[0].Group)
+	count, err := results[0].Count["*"].Int64()
+	require.NoError(t, err)
+	require.EqualValues(t, 42, count)
+}
+
+func TestItemsAlias(t *testing.T) {
+	var query string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, _ = url.QueryUnescape(r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"data": []}`)
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	_, err := items.List(context.Background(), WithAlias("english", "translations"), WithFields("english.*"))
+	require.NoError(t, err)
+	require.Equal(t, `alias[english]=translations&fields[]=english.*&limit=-1`, query)
+}
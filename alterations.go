@@ -1,7 +1,245 @@
 package directus
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// relatedPrimaryKey is the field with the primary key of the related items in the relations that track changes.
+const relatedPrimaryKey = "id"
+
 type Alterations[T any, PK string | int64] struct {
 	Create []*T `json:"create,omitempty"`
 	Update []*T `json:"update,omitempty"`
 	Delete []PK `json:"delete,omitempty"`
 }
+
+// RelationList is a one-to-many or many-to-many relation that remembers the related items it was loaded with. When
+// encoded it sends the Alterations needed to reach the current list: new items are created with their own nested
+// relations, loaded items send only the fields that changed, existing items added to the list are linked by their
+// primary key, and the items that are no longer in the list are deleted. For many-to-many relations T is the junction
+// collection.
+//
+// The primary key of the related items, the "id" field, should be requested to track them.
+type RelationList[T any, PK string | int64] struct {
+	entries  []relationEntry[T, PK]
+	original map[PK][]byte
+}
+
+type relationEntry[T any, PK string | int64] struct {
+	pk    PK
+	item  *T
+	isNew bool
+}
+
+// NewRelationList builds a relation with the items. Items without primary key will be created, the rest will be
+// related to the parent item.
+func NewRelationList[T any, PK string | int64](items ...*T) RelationList[T, PK] {
+	var l RelationList[T, PK]
+	for _, item := range items {
+		l.Add(item)
+	}
+	return l
+}
+
+// Add appends an item to the relation. It will be created if it does not have a primary key, otherwise the existing
+// item is linked without changing its fields.
+func (l *RelationList[T, PK]) Add(item *T) {
+	pk, ok := relatedKey[T, PK](item)
+	if ok {
+		l.remove(pk)
+	}
+	l.entries = append(l.entries, relationEntry[T, PK]{pk: pk, item: item, isNew: !ok})
+}
+
+// Link appends an existing item to the relation using only its primary key.
+func (l *RelationList[T, PK]) Link(pk PK) {
+	l.remove(pk)
+	l.entries = append(l.entries, relationEntry[T, PK]{pk: pk})
+}
+
+// Remove takes out of the relation the item with the primary key.
+func (l *RelationList[T, PK]) Remove(pk PK) {
+	l.remove(pk)
+}
+
+func (l *RelationList[T, PK]) remove(pk PK) {
+	l.entries = slices.DeleteFunc(l.entries, func(entry relationEntry[T, PK]) bool {
+		return !entry.isNew && entry.pk == pk
+	})
+}
+
+// Items returns the related items that are loaded or pending to be sent.
+func (l RelationList[T, PK]) Items() []*T {
+	var items []*T
+	for _, entry := range l.entries {
+		if entry.item != nil {
+			items = append(items, entry.item)
+		}
+	}
+	return items
+}
+
+// Keys returns the primary keys of the related items, excluding the new ones that have not been created yet.
+func (l RelationList[T, PK]) Keys() []PK {
+	var keys []PK
+	for _, entry := range l.entries {
+		if !entry.isNew {
+			keys = append(keys, entry.pk)
+		}
+	}
+	return keys
+}
+
+func (l RelationList[T, PK]) pathTarget() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (l RelationList[T, PK]) relationTarget() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (l RelationList[T, PK]) MarshalJSON() ([]byte, error) {
+	var alt Alterations[json.RawMessage, PK]
+	present := make(map[PK]bool)
+	for _, entry := range l.entries {
+		if entry.isNew {
+			raw, err := json.Marshal(entry.item)
+			if err != nil {
+				return nil, err
+			}
+			alt.Create = append(alt.Create, (*json.RawMessage)(&raw))
+			continue
+		}
+
+		present[entry.pk] = true
+		original, loaded := l.original[entry.pk]
+		if entry.item == nil || original == nil {
+			// Items that were not loaded with their fields are only linked, without overwriting the rest of their
+			// columns with the values of the struct.
+			if !loaded {
+				raw, err := json.Marshal(map[string]any{relatedPrimaryKey: entry.pk})
+				if err != nil {
+					return nil, err
+				}
+				alt.Update = append(alt.Update, (*json.RawMessage)(&raw))
+			}
+			continue
+		}
+		raw, err := json.Marshal(entry.item)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(raw, original) {
+			continue
+		}
+		raw, err = changedFields(original, raw, entry.pk)
+		if err != nil {
+			return nil, err
+		}
+		alt.Update = append(alt.Update, (*json.RawMessage)(&raw))
+	}
+	for _, entry := range l.originalKeys() {
+		if !present[entry] {
+			alt.Delete = append(alt.Delete, entry)
+		}
+	}
+	return json.Marshal(alt)
+}
+
+// originalKeys returns the keys the relation was loaded with in a stable order.
+func (l RelationList[T, PK]) originalKeys() []PK {
+	keys := make([]PK, 0, len(l.original))
+	for pk := range l.original {
+		keys = append(keys, pk)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (l *RelationList[T, PK]) UnmarshalJSON(data []byte) error {
+	*l = RelationList[T, PK]{}
+	if string(data) == "null" {
+		return nil
+	}
+
+	var rows []json.RawMessage
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return fmt.Errorf("directus: cannot decode relation: %v", err)
+	}
+	l.original = make(map[PK][]byte)
+	for _, raw := range rows {
+		raw = bytes.TrimSpace(raw)
+
+		// Relations not expanded only contain the primary keys.
+		if len(raw) == 0 || raw[0] != '{' {
+			var pk PK
+			if err := json.Unmarshal(raw, &pk); err != nil {
+				return fmt.Errorf("directus: cannot decode relation key: %v", err)
+			}
+			l.original[pk] = nil
+			l.entries = append(l.entries, relationEntry[T, PK]{pk: pk})
+			continue
+		}
+
+		item := new(T)
+		if err := json.Unmarshal(raw, item); err != nil {
+			return fmt.Errorf("directus: cannot decode relation: %v", err)
+		}
+		pk, ok := relatedKey[T, PK](item)
+		if !ok {
+			return fmt.Errorf("directus: related item without primary key %q, request it to track the relation", relatedPrimaryKey)
+		}
+		encoded, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		l.original[pk] = encoded
+		l.entries = append(l.entries, relationEntry[T, PK]{pk: pk, item: item})
+	}
+	return nil
+}
+
+// relatedKey reads the primary key of a related item. It returns false if the item does not have one yet.
+func relatedKey[T any, PK string | int64](item *T) (PK, bool) {
+	var pk PK
+	value, err := itemField(item, relatedPrimaryKey)
+	if err != nil {
+		return pk, false
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return pk, false
+	}
+	if err := json.Unmarshal(raw, &pk); err != nil {
+		return pk, false
+	}
+	var zero PK
+	return pk, pk != zero
+}
+
+// changedFields encodes the fields of an item that are different from the original ones, together with its primary
+// key.
+func changedFields[PK string | int64](original, current []byte, pk PK) ([]byte, error) {
+	var before, after map[string]json.RawMessage
+	if err := json.Unmarshal(original, &before); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(current, &after); err != nil {
+		return nil, err
+	}
+	for k, v := range after {
+		if bytes.Equal(before[k], v) {
+			delete(after, k)
+		}
+	}
+	key, err := json.Marshal(pk)
+	if err != nil {
+		return nil, err
+	}
+	after[relatedPrimaryKey] = key
+	return json.Marshal(after)
+}
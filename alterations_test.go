@@ -0,0 +1,123 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type Hotel struct {
+	ID    string                    `json:"id,omitempty"`
+	Name  string                    `json:"name"`
+	Rooms RelationList[Room, int64] `json:"rooms"`
+}
+
+type Room struct {
+	ID   int64                    `json:"id,omitempty"`
+	Name string                   `json:"name"`
+	Beds RelationList[Bed, int64] `json:"beds,omitempty"`
+}
+
+type Bed struct {
+	ID   int64  `json:"id,omitempty"`
+	Size string `json:"size"`
+}
+
+func TestRelationListUpdate(t *testing.T) {
+	var hotel Hotel
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "h1",
+		"name": "Hotel",
+		"rooms": [
+			{"id": 1, "name": "Single", "beds": [5]},
+			{"id": 2, "name": "Double", "beds": [6, 7]},
+			{"id": 3, "name": "Suite", "beds": [8]}
+		]
+	}`), &hotel))
+	require.Equal(t, []int64{1, 2, 3}, hotel.Rooms.Keys())
+	require.Len(t, hotel.Rooms.Items(), 3)
+
+	hotel.Rooms.Items()[1].Name = "Twin"
+	hotel.Rooms.Remove(3)
+	hotel.Rooms.Link(4)
+	hotel.Rooms.Add(&Room{
+		Name: "Family",
+		Beds: NewRelationList[Bed, int64](&Bed{Size: "king"}, &Bed{Size: "single"}),
+	})
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+		require.Equal(t, "/items/hotels/h1", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"id": "h1",
+			"name": "Hotel",
+			"rooms": {
+				"create": [
+					{"name": "Family", "beds": {"create": [{"size": "king"}, {"size": "single"}]}}
+				],
+				"update": [
+					{"id": 2, "name": "Twin"},
+					{"id": 4}
+				],
+				"delete": [3]
+			}
+		}`, string(body))
+		fmt.Fprint(w, `{"data": {"id": "h1", "name": "Hotel", "rooms": [1, 2, 4, 9]}}`)
+	}))
+	defer s.Close()
+	items := NewItemsClient[Hotel](NewClient(s.URL, "local-token"), "hotels")
+
+	updated, err := items.Update(context.Background(), "h1", &hotel)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 4, 9}, updated.Rooms.Keys())
+	require.Empty(t, updated.Rooms.Items())
+
+	b, err := json.Marshal(updated.Rooms)
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(b))
+}
+
+func TestRelationListCreate(t *testing.T) {
+	hotel := &Hotel{
+		Name:  "Hotel",
+		Rooms: NewRelationList[Room, int64](&Room{Name: "Single"}, &Room{ID: 4, Name: "Ignored"}),
+	}
+	b, err := json.Marshal(hotel)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"name": "Hotel",
+		"rooms": {
+			"create": [{"name": "Single", "beds": {}}],
+			"update": [{"id": 4}]
+		}
+	}`, string(b))
+}
+
+func TestRelationListWithoutPrimaryKey(t *testing.T) {
+	var hotel Hotel
+	require.Error(t, json.Unmarshal([]byte(`{"rooms": [{"name": "Single"}]}`), &hotel))
+}
+
+func TestRelationListPaths(t *testing.T) {
+	_, err := Path[Hotel]("rooms.beds.size")
+	require.NoError(t, err)
+}
+
+func TestRelationListLinkLoaded(t *testing.T) {
+	var hotel Hotel
+	require.NoError(t, json.Unmarshal([]byte(`{"rooms": [{"id": 1, "name": "Single"}, 2]}`), &hotel))
+	hotel.Rooms.Link(1)
+	hotel.Rooms.Add(&Room{ID: 2, Name: "Ignored"})
+
+	b, err := json.Marshal(hotel.Rooms)
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(b))
+}
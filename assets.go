@@ -0,0 +1,166 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type clientAssets struct {
+	client *Client
+}
+
+// Asset is the contents of a file downloaded from the server. It should be closed after reading it.
+type Asset struct {
+	io.ReadCloser
+
+	ContentType string
+
+	// ContentLength is the size of the contents or -1 if unknown.
+	ContentLength int64
+}
+
+// AssetFit is how an image fits the requested width and height.
+type AssetFit string
+
+const (
+	AssetFitCover   AssetFit = "cover"
+	AssetFitContain AssetFit = "contain"
+	AssetFitInside  AssetFit = "inside"
+	AssetFitOutside AssetFit = "outside"
+)
+
+// AssetFormat is the output format of a transformed image.
+type AssetFormat string
+
+const (
+	AssetFormatAuto AssetFormat = "auto"
+	AssetFormatJPG  AssetFormat = "jpg"
+	AssetFormatPNG  AssetFormat = "png"
+	AssetFormatWebP AssetFormat = "webp"
+	AssetFormatTIFF AssetFormat = "tiff"
+	AssetFormatAVIF AssetFormat = "avif"
+)
+
+// AssetTransform describes the transformation applied by the server to an image before returning it. Zero values
+// are not sent.
+type AssetTransform struct {
+	// Key selects a transformation preset configured in the project settings.
+	Key string
+
+	Width              int64
+	Height             int64
+	Fit                AssetFit
+	Quality            int64
+	Format             AssetFormat
+	WithoutEnlargement bool
+
+	// Transforms are raw Sharp operations applied in order, for example {"blur", 10} or {"rotate", 90}.
+	Transforms [][]any
+}
+
+func (transform *AssetTransform) values() (url.Values, error) {
+	q := make(url.Values)
+	if transform == nil {
+		return q, nil
+	}
+	if transform.Key != "" {
+		q.Set("key", transform.Key)
+	}
+	if transform.Width > 0 {
+		q.Set("width", strconv.FormatInt(transform.Width, 10))
+	}
+	if transform.Height > 0 {
+		q.Set("height", strconv.FormatInt(transform.Height, 10))
+	}
+	if transform.Fit != "" {
+		q.Set("fit", string(transform.Fit))
+	}
+	if transform.Quality > 0 {
+		q.Set("quality", strconv.FormatInt(transform.Quality, 10))
+	}
+	if transform.Format != "" {
+		q.Set("format", string(transform.Format))
+	}
+	if transform.WithoutEnlargement {
+		q.Set("withoutEnlargement", "true")
+	}
+	if len(transform.Transforms) > 0 {
+		b, err := json.Marshal(transform.Transforms)
+		if err != nil {
+			return nil, fmt.Errorf("directus: cannot encode transforms: %v", err)
+		}
+		q.Set("transforms", string(b))
+	}
+	return q, nil
+}
+
+// Download streams the contents of a file, optionally transformed if it is an image. The transform can be nil.
+func (ca *clientAssets) Download(ctx context.Context, id string, transform *AssetTransform) (*Asset, error) {
+	u, err := ca.assetURL(id, transform)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	resp, err := ca.client.openRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return &Asset{
+		ReadCloser:    resp.Body,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+	}, nil
+}
+
+// URL returns the public address of a file, optionally transformed if it is an image. The transform can be nil.
+// The file should be accessible to the public role to use it.
+func (ca *clientAssets) URL(id string, transform *AssetTransform) (string, error) {
+	u, err := ca.assetURL(id, transform)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// SignedURL returns the address of a file that includes the access token of the client to read it without additional
+// authentication. It should only be shared with trusted parties, as anyone could use the token.
+func (ca *clientAssets) SignedURL(ctx context.Context, id string, transform *AssetTransform) (string, error) {
+	u, err := ca.assetURL(id, transform)
+	if err != nil {
+		return "", err
+	}
+	token, err := ca.client.tokenSource().Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("directus: cannot obtain token: %w", err)
+	}
+	if token != "" {
+		q := u.Query()
+		q.Set("access_token", token)
+		u.RawQuery = q.Encode()
+	}
+	return u.String(), nil
+}
+
+func (ca *clientAssets) assetURL(id string, transform *AssetTransform) (*url.URL, error) {
+	if id == "" {
+		return nil, fmt.Errorf("directus: asset id is required")
+	}
+	u, err := url.Parse(ca.client.urlf("/assets/%s", url.PathEscape(id)))
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot parse asset url: %v", err)
+	}
+	q, err := transform.values()
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = q.Encode()
+	return u, nil
+}
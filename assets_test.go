@@ -0,0 +1,77 @@
+package directus
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetsDownload(t *testing.T) {
+	var query string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/assets/file-1", r.URL.Path)
+		require.Equal(t, "Bearer local-token", r.Header.Get("Authorization"))
+		query, _ = url.QueryUnescape(r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "image/webp")
+		w.Header().Set("Content-Length", "11")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "image-bytes")
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	asset, err := client.Assets.Download(context.Background(), "file-1", &AssetTransform{
+		Width:              300,
+		Height:             200,
+		Fit:                AssetFitCover,
+		Quality:            80,
+		Format:             AssetFormatWebP,
+		WithoutEnlargement: true,
+		Transforms:         [][]any{{"blur", 10}},
+	})
+	require.NoError(t, err)
+	defer asset.Close()
+
+	content, err := io.ReadAll(asset)
+	require.NoError(t, err)
+	require.Equal(t, "image-bytes", string(content))
+	require.Equal(t, "image/webp", asset.ContentType)
+	require.EqualValues(t, 11, asset.ContentLength)
+
+	require.Equal(t, `fit=cover&format=webp&height=200&quality=80&transforms=[["blur",10]]&width=300&withoutEnlargement=true`, query)
+}
+
+func TestAssetsDownloadNotFound(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errors": [{"message": "You don't have permission to access this.", "extensions": {"code": "FORBIDDEN"}}]}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	_, err := client.Assets.Download(context.Background(), "file-1", nil)
+	require.ErrorIs(t, err, ErrorCodeForbidden)
+}
+
+func TestAssetsURL(t *testing.T) {
+	client := NewClient("https://directus.example.com/", "local-token")
+
+	u, err := client.Assets.URL("file-1", &AssetTransform{Key: "thumbnail"})
+	require.NoError(t, err)
+	require.Equal(t, "https://directus.example.com/assets/file-1?key=thumbnail", u)
+
+	u, err = client.Assets.URL("file-1", nil)
+	require.NoError(t, err)
+	require.Equal(t, "https://directus.example.com/assets/file-1", u)
+
+	u, err = client.Assets.SignedURL(context.Background(), "file-1", &AssetTransform{Width: 100})
+	require.NoError(t, err)
+	require.Equal(t, "https://directus.example.com/assets/file-1?access_token=local-token&width=100", u)
+}
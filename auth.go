@@ -0,0 +1,212 @@
+package directus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tokenExpiryMargin is how long before the expiration the access token of a session is refreshed.
+const tokenExpiryMargin = 30 * time.Second
+
+// TokenSource provides the access token to authenticate each request sent to the server. It should be safe for
+// concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// tokenRefresher is implemented by the token sources that can obtain a new token when the server rejects the current
+// one as expired.
+type tokenRefresher interface {
+	refreshExpired(ctx context.Context, expired string) (string, error)
+}
+
+type staticToken string
+
+func (token staticToken) Token(ctx context.Context) (string, error) {
+	return string(token), nil
+}
+
+// StaticToken returns a token source that always authenticates with the same token.
+func StaticToken(token string) TokenSource {
+	return staticToken(token)
+}
+
+// WithTokenSource authenticates every request with the tokens of the source instead of the static token.
+func WithTokenSource(tokens TokenSource) ClientOption {
+	return func(client *Client) {
+		client.tokens = tokens
+	}
+}
+
+type clientAuth struct {
+	client *Client
+}
+
+type authTokens struct {
+	AccessToken  string `json:"access_token"`
+	Expires      int64  `json:"expires"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Login authenticates a user with its email and password. The returned session is used to authenticate every
+// following request of the client, refreshing the access token when needed.
+func (ca *clientAuth) Login(ctx context.Context, email, password string) (*Session, error) {
+	request := map[string]string{
+		"email":    email,
+		"password": password,
+		"mode":     "json",
+	}
+	session := &Session{client: ca.client}
+	if err := session.authenticate(ctx, "/auth/login", request); err != nil {
+		return nil, err
+	}
+	ca.client.setTokenSource(session)
+	return session, nil
+}
+
+// Logout invalidates the refresh token of the current session in the server. The client will not be authenticated
+// after it.
+func (ca *clientAuth) Logout(ctx context.Context) error {
+	session, ok := ca.client.tokenSource().(*Session)
+	if !ok {
+		return fmt.Errorf("directus: client is not authenticated with a session")
+	}
+	if err := session.logout(ctx); err != nil {
+		return err
+	}
+	ca.client.setTokenSource(StaticToken(""))
+	return nil
+}
+
+// Session keeps the tokens of a user authenticated with Auth.Login. It is safe for concurrent use.
+type Session struct {
+	client *Client
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expires      time.Time
+}
+
+// Token returns the current access token, refreshing it first if it is about to expire.
+func (session *Session) Token(ctx context.Context) (string, error) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.refreshToken == "" {
+		return "", ErrLoggedOut
+	}
+	if time.Until(session.expires) < tokenExpiryMargin {
+		if err := session.refresh(ctx); err != nil {
+			return "", err
+		}
+	}
+	return session.accessToken, nil
+}
+
+// Refresh obtains a new access token from the server.
+func (session *Session) Refresh(ctx context.Context) error {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.refreshToken == "" {
+		return ErrLoggedOut
+	}
+	return session.refresh(ctx)
+}
+
+// Expires returns when the current access token will expire.
+func (session *Session) Expires() time.Time {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	return session.expires
+}
+
+func (session *Session) refreshExpired(ctx context.Context, expired string) (string, error) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.refreshToken == "" {
+		return "", ErrLoggedOut
+	}
+
+	// Another request could have refreshed the token in the meantime.
+	if session.accessToken != expired {
+		return session.accessToken, nil
+	}
+
+	if err := session.refresh(ctx); err != nil {
+		return "", err
+	}
+	return session.accessToken, nil
+}
+
+func (session *Session) refresh(ctx context.Context) error {
+	request := map[string]string{
+		"refresh_token": session.refreshToken,
+		"mode":          "json",
+	}
+	return session.authenticate(ctx, "/auth/refresh", request)
+}
+
+func (session *Session) authenticate(ctx context.Context, endpoint string, request any) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(request); err != nil {
+		return fmt.Errorf("directus: cannot encode request: %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, session.client.urlf("%s", endpoint), &buf)
+	if err != nil {
+		return fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	reply := struct {
+		Data *authTokens `json:"data"`
+	}{}
+	if err := session.client.sendRequestToken(req, "", &reply); err != nil {
+		return err
+	}
+	if reply.Data == nil || reply.Data.AccessToken == "" {
+		return fmt.Errorf("directus: authentication reply without tokens")
+	}
+
+	session.accessToken = reply.Data.AccessToken
+	session.refreshToken = reply.Data.RefreshToken
+	session.expires = time.Now().Add(time.Duration(reply.Data.Expires) * time.Millisecond)
+	return nil
+}
+
+func (session *Session) logout(ctx context.Context) error {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.refreshToken == "" {
+		return ErrLoggedOut
+	}
+
+	var buf bytes.Buffer
+	request := map[string]string{
+		"refresh_token": session.refreshToken,
+		"mode":          "json",
+	}
+	if err := json.NewEncoder(&buf).Encode(request); err != nil {
+		return fmt.Errorf("directus: cannot encode request: %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, session.client.urlf("/auth/logout"), &buf)
+	if err != nil {
+		return fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	if err := session.client.sendRequestToken(req, "", nil); err != nil && !errors.Is(err, ErrEmpty) {
+		return err
+	}
+
+	session.accessToken = ""
+	session.refreshToken = ""
+	session.expires = time.Time{}
+	return nil
+}
@@ -0,0 +1,142 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type authServer struct {
+	expires   int64
+	refreshes atomic.Int32
+	logouts   atomic.Int32
+
+	mu      sync.Mutex
+	current string
+	expired map[string]bool
+}
+
+func newAuthServer(t *testing.T, expires int64) (*authServer, *httptest.Server) {
+	as := &authServer{expires: expires, expired: make(map[string]bool)}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+		}
+
+		as.mu.Lock()
+		defer as.mu.Unlock()
+
+		switch r.URL.Path {
+		case "/auth/login":
+			require.Empty(t, r.Header.Get("Authorization"))
+			if body["email"] != "foo@example.com" || body["password"] != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"errors": [{"message": "Invalid user credentials.", "extensions": {"code": "INVALID_CREDENTIALS"}}]}`)
+				return
+			}
+			as.current = "access-0"
+			fmt.Fprintf(w, `{"data": {"access_token": "access-0", "expires": %d, "refresh_token": "refresh-0"}}`, as.expires)
+
+		case "/auth/refresh":
+			n := as.refreshes.Add(1)
+			require.Equal(t, fmt.Sprintf("refresh-%d", n-1), body["refresh_token"])
+			as.current = fmt.Sprintf("access-%d", n)
+			fmt.Fprintf(w, `{"data": {"access_token": "access-%d", "expires": %d, "refresh_token": "refresh-%d"}}`, n, as.expires, n)
+
+		case "/auth/logout":
+			as.logouts.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			token := r.Header.Get("Authorization")
+			if token != "Bearer "+as.current || as.expired[as.current] {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"errors": [{"message": "Token expired.", "extensions": {"code": "TOKEN_EXPIRED"}}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"data": {"version": "11.0.2"}}`)
+		}
+	}))
+	return as, s
+}
+
+func TestAuthLogin(t *testing.T) {
+	as, s := newAuthServer(t, 900000)
+	defer s.Close()
+	client := NewClient(s.URL, "")
+
+	_, err := client.Auth.Login(context.Background(), "foo@example.com", "wrong")
+	require.Error(t, err)
+
+	session, err := client.Auth.Login(context.Background(), "foo@example.com", "secret")
+	require.NoError(t, err)
+	require.NotZero(t, session.Expires())
+
+	info, err := client.Server.Info(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "11.0.2", info.Version)
+	require.Zero(t, as.refreshes.Load())
+}
+
+func TestAuthRefreshBeforeExpiry(t *testing.T) {
+	as, s := newAuthServer(t, 1000)
+	defer s.Close()
+	client := NewClient(s.URL, "")
+
+	_, err := client.Auth.Login(context.Background(), "foo@example.com", "secret")
+	require.NoError(t, err)
+
+	_, err = client.Server.Info(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, as.refreshes.Load())
+}
+
+func TestAuthRefreshExpiredConcurrently(t *testing.T) {
+	as, s := newAuthServer(t, 900000)
+	defer s.Close()
+	client := NewClient(s.URL, "")
+
+	_, err := client.Auth.Login(context.Background(), "foo@example.com", "secret")
+	require.NoError(t, err)
+
+	as.mu.Lock()
+	as.expired["access-0"] = true
+	as.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Server.Info(context.Background())
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, 1, as.refreshes.Load())
+}
+
+func TestAuthLogout(t *testing.T) {
+	as, s := newAuthServer(t, 900000)
+	defer s.Close()
+	client := NewClient(s.URL, "")
+
+	session, err := client.Auth.Login(context.Background(), "foo@example.com", "secret")
+	require.NoError(t, err)
+	require.NoError(t, client.Auth.Logout(context.Background()))
+	require.EqualValues(t, 1, as.logouts.Load())
+
+	_, err = session.Token(context.Background())
+	require.ErrorIs(t, err, ErrLoggedOut)
+
+	require.Error(t, client.Auth.Logout(context.Background()))
+}
@@ -0,0 +1,197 @@
+package directus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const defaultBatchSize = 100
+
+type batchOptions struct {
+	size int
+}
+
+// BatchOption configures a batch operation.
+type BatchOption func(opts *batchOptions)
+
+// WithBatchSize changes the maximum number of items or keys sent in each request. By default it sends 100 of them.
+func WithBatchSize(size int) BatchOption {
+	return func(opts *batchOptions) {
+		opts.size = size
+	}
+}
+
+// runChunks calls fn with consecutive ranges of the total number of elements. Failed chunks are collected in a
+// BatchError and the rest of them are still processed unless the context is cancelled.
+func runChunks(ctx context.Context, total int, opts []BatchOption, fn func(start, end int) error) error {
+	bo := batchOptions{size: defaultBatchSize}
+	for _, opt := range opts {
+		opt(&bo)
+	}
+	if bo.size <= 0 {
+		bo.size = defaultBatchSize
+	}
+
+	var batchErr BatchError
+	for start := 0; start < total; start += bo.size {
+		if err := ctx.Err(); err != nil {
+			batchErr.Chunks = append(batchErr.Chunks, &ChunkError{Offset: start, Size: total - start, Err: err})
+			break
+		}
+		end := min(start+bo.size, total)
+		if err := fn(start, end); err != nil {
+			batchErr.Chunks = append(batchErr.Chunks, &ChunkError{Offset: start, Size: end - start, Err: err})
+		}
+	}
+	if len(batchErr.Chunks) > 0 {
+		return &batchErr
+	}
+	return nil
+}
+
+// CreateMany creates multiple items in the collection, sending them in chunks. If some chunks fail it returns the
+// items created by the rest of them together with a *BatchError.
+func (items *ItemsClient[T]) CreateMany(ctx context.Context, create []*T, opts ...BatchOption) ([]*T, error) {
+	var results []*T
+	err := runChunks(ctx, len(create), opts, func(start, end int) error {
+		reply := struct {
+			Data []*T `json:"data"`
+		}{}
+		if err := items.itemsdo(ctx, http.MethodPost, items.c.urlf("/items/%s", items.collection), create[start:end], &reply); err != nil {
+			return err
+		}
+		results = append(results, reply.Data...)
+		return nil
+	})
+	return results, err
+}
+
+// UpdateMany applies the same changes to multiple items of the collection by their primary keys, sending them in
+// chunks. If some chunks fail it returns the items updated by the rest of them together with a *BatchError.
+func (items *ItemsClient[T]) UpdateMany(ctx context.Context, ids []string, item *T, opts ...BatchOption) ([]*T, error) {
+	return updateMany(ctx, items, ids, item, opts)
+}
+
+func updateMany[T any, PK string | int64](ctx context.Context, items *ItemsClient[T], ids []PK, item *T, opts []BatchOption) ([]*T, error) {
+	var results []*T
+	err := runChunks(ctx, len(ids), opts, func(start, end int) error {
+		request := struct {
+			Keys []PK `json:"keys"`
+			Data *T   `json:"data"`
+		}{
+			Keys: ids[start:end],
+			Data: item,
+		}
+		reply := struct {
+			Data []*T `json:"data"`
+		}{}
+		if err := items.itemsdo(ctx, http.MethodPatch, items.c.urlf("/items/%s", items.collection), request, &reply); err != nil {
+			return err
+		}
+		results = append(results, reply.Data...)
+		return nil
+	})
+	return results, err
+}
+
+// UpdateFilter applies the same changes to all the items of the collection that match the filter. The filter is
+// required to avoid updating the whole collection by mistake; use UpdateMany with the keys of every item instead.
+func (items *ItemsClient[T]) UpdateFilter(ctx context.Context, filter Filter, item *T) ([]*T, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("directus: filter is required to update items")
+	}
+	if isEmptyFilter(filter) {
+		return nil, fmt.Errorf("directus: cannot update items with an empty filter")
+	}
+
+	request := struct {
+		Query struct {
+			Filter any   `json:"filter"`
+			Limit  int64 `json:"limit"`
+		} `json:"query"`
+		Data *T `json:"data"`
+	}{
+		Data: item,
+	}
+	request.Query.Filter = filter.content()
+	request.Query.Limit = -1
+	reply := struct {
+		Data []*T `json:"data"`
+	}{}
+	if err := items.itemsdo(ctx, http.MethodPatch, items.c.urlf("/items/%s", items.collection), request, &reply); err != nil {
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+// DeleteMany deletes multiple items from the collection by their primary keys, sending them in chunks. If some chunks
+// fail it returns a *BatchError.
+func (items *ItemsClient[T]) DeleteMany(ctx context.Context, ids []string, opts ...BatchOption) error {
+	return deleteMany(ctx, items, ids, opts)
+}
+
+func deleteMany[T any, PK string | int64](ctx context.Context, items *ItemsClient[T], ids []PK, opts []BatchOption) error {
+	return runChunks(ctx, len(ids), opts, func(start, end int) error {
+		return items.itemsdo(ctx, http.MethodDelete, items.c.urlf("/items/%s", items.collection), ids[start:end], nil)
+	})
+}
+
+// CreateMany creates multiple resources, sending them in chunks. If some chunks fail it returns the resources created
+// by the rest of them together with a *BatchError.
+func (rc *ResourceClient[T, PK]) CreateMany(ctx context.Context, create []*T, opts ...BatchOption) ([]*T, error) {
+	for _, item := range create {
+		if before, ok := any(item).(beforeDirectuser); ok {
+			before.BeforeDirectus()
+		}
+	}
+
+	var results []*T
+	err := runChunks(ctx, len(create), opts, func(start, end int) error {
+		reply := struct {
+			Data []*T `json:"data"`
+		}{}
+		if err := rc.resourcedo(ctx, http.MethodPost, rc.client.urlf("/%s", rc.endpoint), create[start:end], &reply); err != nil {
+			return err
+		}
+		results = append(results, reply.Data...)
+		return nil
+	})
+	return results, err
+}
+
+// UpdateMany applies the same changes to multiple resources by their primary keys, sending them in chunks. If some
+// chunks fail it returns the resources updated by the rest of them together with a *BatchError.
+func (rc *ResourceClient[T, PK]) UpdateMany(ctx context.Context, ids []PK, item *T, opts ...BatchOption) ([]*T, error) {
+	if before, ok := any(item).(beforeDirectuser); ok {
+		before.BeforeDirectus()
+	}
+
+	var results []*T
+	err := runChunks(ctx, len(ids), opts, func(start, end int) error {
+		request := struct {
+			Keys []PK `json:"keys"`
+			Data *T   `json:"data"`
+		}{
+			Keys: ids[start:end],
+			Data: item,
+		}
+		reply := struct {
+			Data []*T `json:"data"`
+		}{}
+		if err := rc.resourcedo(ctx, http.MethodPatch, rc.client.urlf("/%s", rc.endpoint), request, &reply); err != nil {
+			return err
+		}
+		results = append(results, reply.Data...)
+		return nil
+	})
+	return results, err
+}
+
+// DeleteMany deletes multiple resources by their primary keys, sending them in chunks. If some chunks fail it returns a
+// *BatchError.
+func (rc *ResourceClient[T, PK]) DeleteMany(ctx context.Context, ids []PK, opts ...BatchOption) error {
+	return runChunks(ctx, len(ids), opts, func(start, end int) error {
+		return rc.resourcedo(ctx, http.MethodDelete, rc.client.urlf("/%s", rc.endpoint), ids[start:end], nil)
+	})
+}
@@ -0,0 +1,184 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsCreateMany(t *testing.T) {
+	var sizes []int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		var body []*Regime
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		sizes = append(sizes, len(body))
+
+		if body[0].Code == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errors": [{"message": "Duplicated.", "extensions": {"code": "RECORD_NOT_UNIQUE"}}]}`)
+			return
+		}
+		for _, regime := range body {
+			regime.ID = "id-" + regime.Code
+		}
+		w.WriteHeader(http.StatusOK)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": body}))
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	create := []*Regime{
+		{Code: "a"}, {Code: "b"},
+		{Code: "fail"}, {Code: "d"},
+		{Code: "e"},
+	}
+	results, err := items.CreateMany(context.Background(), create, WithBatchSize(2))
+	require.Equal(t, []int{2, 2, 1}, sizes)
+
+	var batchErr *BatchError
+	require.True(t, errors.As(err, &batchErr))
+	require.Len(t, batchErr.Chunks, 1)
+	require.Equal(t, 2, batchErr.Chunks[0].Offset)
+	require.Equal(t, 2, batchErr.Chunks[0].Size)
+	require.ErrorIs(t, err, ErrorCodeRecordNotUnique)
+
+	require.Len(t, results, 3)
+	require.Equal(t, "id-a", results[0].ID)
+	require.Equal(t, "id-e", results[2].ID)
+}
+
+func TestItemsUpdateMany(t *testing.T) {
+	s, batch := newBatchServer(t, http.MethodPatch, "/items/regimes", `[{"id": "1", "status": "draft"}]`)
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	results, err := items.UpdateMany(context.Background(), []string{"1", "2", "3"}, &Regime{Status: "draft"}, WithBatchSize(2))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Len(t, batch.bodies, 2)
+	require.JSONEq(t, `{"keys": ["1", "2"], "data": {"status": "draft"}}`, batch.bodies[0])
+	require.JSONEq(t, `{"keys": ["3"], "data": {"status": "draft"}}`, batch.bodies[1])
+
+	batch.bodies = nil
+	_, err = items.UpdateFilter(context.Background(), Eq("status", "published"), &Regime{Status: "draft"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"query": {"filter": {"status": {"_eq": "published"}}, "limit": -1}, "data": {"status": "draft"}}`, batch.bodies[0])
+}
+
+func TestItemsUpdateFilterRequired(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Fail(t, "unexpected request", r.URL.String())
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	_, err := items.UpdateFilter(context.Background(), nil, &Regime{Status: "draft"})
+	require.EqualError(t, err, "directus: filter is required to update items")
+
+	for _, filter := range []Filter{Noop(), And(), And(Noop()), Or(And(), Noop())} {
+		_, err = items.UpdateFilter(context.Background(), filter, &Regime{Status: "draft"})
+		require.EqualError(t, err, "directus: cannot update items with an empty filter")
+	}
+}
+
+func TestItemsDeleteMany(t *testing.T) {
+	s, batch := newBatchServer(t, http.MethodDelete, "/items/regimes", "")
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	require.NoError(t, items.DeleteMany(context.Background(), []string{"1", "2", "3"}, WithBatchSize(2)))
+	require.Len(t, batch.bodies, 2)
+	require.JSONEq(t, `["1", "2"]`, batch.bodies[0])
+	require.JSONEq(t, `["3"]`, batch.bodies[1])
+}
+
+func TestItemsBatchCancelled(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := items.DeleteMany(ctx, []string{"1", "2", "3"}, WithBatchSize(2))
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestResourceCreateMany(t *testing.T) {
+	s, batch := newBatchServer(t, http.MethodPost, "/roles", `[{"id": "r1", "name": "Editor"}]`)
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	roles := []*Role{
+		{Name: "Editor", Policies: []RolePolicy{{ID: "p1"}}},
+		{Name: "Viewer"},
+		{Name: "Admin", AdminAccess: true},
+	}
+	results, err := client.Roles.CreateMany(context.Background(), roles, WithBatchSize(2))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, []string{"*", "policies.id", "policies.policy"}, batch.query["fields[]"])
+	require.Len(t, batch.bodies, 2)
+	require.JSONEq(t, `[
+		{"name": "Editor", "admin_access": false, "app_access": false, "policies": {"create": [{"id": "", "policy": "p1"}]}},
+		{"name": "Viewer", "admin_access": false, "app_access": false, "policies": {}}
+	]`, batch.bodies[0])
+	require.JSONEq(t, `[{"name": "Admin", "admin_access": true, "app_access": false, "policies": {}}]`, batch.bodies[1])
+}
+
+func TestResourceUpdateMany(t *testing.T) {
+	s, batch := newBatchServer(t, http.MethodPatch, "/presets", `[{"id": 1, "collection": "regimes"}]`)
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	results, err := client.Presets.UpdateMany(context.Background(), []int64{1, 2, 3}, &Preset{Collection: "regimes"}, WithBatchSize(2))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Len(t, batch.bodies, 2)
+	require.Contains(t, batch.bodies[0], `"keys":[1,2]`)
+	require.Contains(t, batch.bodies[1], `"keys":[3]`)
+}
+
+func TestResourceDeleteMany(t *testing.T) {
+	s, batch := newBatchServer(t, http.MethodDelete, "/presets", "")
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	require.NoError(t, client.Presets.DeleteMany(context.Background(), []int64{1, 2, 3}))
+	require.Equal(t, []string{"[1,2,3]"}, batch.bodies)
+}
+
+// batchServer records the bodies and the last query of the batch requests it receives.
+type batchServer struct {
+	bodies []string
+	query  url.Values
+}
+
+// newBatchServer starts a server that checks the method and path of the batch requests and records them. It replies
+// with the data, or without content if it is empty.
+func newBatchServer(t *testing.T, method, path, data string) (*httptest.Server, *batchServer) {
+	batch := new(batchServer)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, method, r.Method)
+		require.Equal(t, path, r.URL.Path)
+		var body json.RawMessage
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		batch.bodies = append(batch.bodies, string(body))
+		batch.query = r.URL.Query()
+		if data == "" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprintf(w, `{"data": %s}`, data)
+	}))
+	return s, batch
+}
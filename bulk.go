@@ -0,0 +1,314 @@
+package directus
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+type bulkOptions struct {
+	workers     int
+	batchSize   int
+	upsertField string
+	progress    func(BulkProgress)
+}
+
+// BulkOption configures a bulk loader.
+type BulkOption func(opts *bulkOptions)
+
+// WithWorkers changes the number of batches sent to the server in parallel. By default it uses 4 workers.
+func WithWorkers(workers int) BulkOption {
+	return func(opts *bulkOptions) {
+		opts.workers = workers
+	}
+}
+
+// WithBulkBatchSize changes the number of items sent together in each batch. By default it sends 100 of them.
+func WithBulkBatchSize(size int) BulkOption {
+	return func(opts *bulkOptions) {
+		opts.batchSize = size
+	}
+}
+
+// WithUpsertField updates the existing items that have the same value in the field instead of creating them again.
+// The field should be unique in the collection.
+func WithUpsertField(field string) BulkOption {
+	return func(opts *bulkOptions) {
+		opts.upsertField = field
+	}
+}
+
+// WithProgress calls fn after each batch is processed with the accumulated counts. Calls are never concurrent.
+func WithProgress(fn func(progress BulkProgress)) BulkOption {
+	return func(opts *bulkOptions) {
+		opts.progress = fn
+	}
+}
+
+// BulkProgress counts the items processed by a bulk loader so far.
+type BulkProgress struct {
+	Processed int
+	Created   int
+	Updated   int
+	Failed    int
+}
+
+// BulkReport is the result of a bulk load.
+type BulkReport[T any] struct {
+	Created int
+	Updated int
+	Failed  []*BulkFailure[T]
+}
+
+// BulkFailure is an item that could not be written by the bulk loader.
+type BulkFailure[T any] struct {
+	// Index is the position of the item in the source.
+	Index int
+	Item  *T
+	Err   error
+}
+
+func (failure *BulkFailure[T]) Error() string {
+	return fmt.Sprintf("directus: item %d: %v", failure.Index, failure.Err)
+}
+
+func (failure *BulkFailure[T]) Unwrap() error {
+	return failure.Err
+}
+
+// BulkLoader writes a large number of items to a collection in batches sent concurrently.
+type BulkLoader[T any] struct {
+	items *ItemsClient[T]
+	bulkOptions
+}
+
+// NewBulkLoader prepares a loader that writes items using the client.
+func NewBulkLoader[T any](items *ItemsClient[T], opts ...BulkOption) *BulkLoader[T] {
+	loader := &BulkLoader[T]{
+		items: items,
+		bulkOptions: bulkOptions{
+			workers:   4,
+			batchSize: defaultBatchSize,
+		},
+	}
+	for _, opt := range opts {
+		opt(&loader.bulkOptions)
+	}
+	loader.workers = max(loader.workers, 1)
+	loader.batchSize = max(loader.batchSize, 1)
+	return loader
+}
+
+type bulkBatch[T any] struct {
+	start int
+	items []*T
+}
+
+type bulkState[T any] struct {
+	mu       sync.Mutex
+	report   BulkReport[T]
+	progress BulkProgress
+}
+
+// Load writes all the items of the source. Failures of individual items are collected in the report and do not stop
+// the load. It only returns an error if the context is cancelled, together with the report of the items processed
+// until then; the items already read from the source that could not be sent are reported as failed with the error of
+// the context.
+func (loader *BulkLoader[T]) Load(ctx context.Context, src iter.Seq[*T]) (*BulkReport[T], error) {
+	if loader.upsertField != "" {
+		// Read the primary key once before starting the workers.
+		if _, err := loader.items.primaryKey(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	state := new(bulkState[T])
+	batches := make(chan *bulkBatch[T])
+	var wg sync.WaitGroup
+	for range loader.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				loader.process(ctx, state, batch)
+			}
+		}()
+	}
+
+	batch := new(bulkBatch[T])
+	var index int
+	send := func() bool {
+		select {
+		case batches <- batch:
+			batch = &bulkBatch[T]{start: index}
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	for item := range src {
+		batch.items = append(batch.items, item)
+		index++
+		if len(batch.items) == loader.batchSize && !send() {
+			break
+		}
+	}
+	if len(batch.items) > 0 && (ctx.Err() != nil || !send()) {
+		// The items were already taken from the source, report them as failed instead of dropping them.
+		loader.process(ctx, state, batch)
+	}
+	close(batches)
+	wg.Wait()
+
+	slices.SortFunc(state.report.Failed, func(a, b *BulkFailure[T]) int {
+		return a.Index - b.Index
+	})
+	return &state.report, ctx.Err()
+}
+
+// LoadChan writes all the items received from the channel until it is closed.
+func (loader *BulkLoader[T]) LoadChan(ctx context.Context, src <-chan *T) (*BulkReport[T], error) {
+	return loader.Load(ctx, func(yield func(*T) bool) {
+		for {
+			select {
+			case item, ok := <-src:
+				if !ok || !yield(item) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+func (loader *BulkLoader[T]) process(ctx context.Context, state *bulkState[T], batch *bulkBatch[T]) {
+	var created, updated int
+	var failures []*BulkFailure[T]
+	fail := func(i int, err error) {
+		failures = append(failures, &BulkFailure[T]{Index: batch.start + i, Item: batch.items[i], Err: err})
+	}
+
+	if err := ctx.Err(); err != nil {
+		for i := range batch.items {
+			fail(i, err)
+		}
+	} else {
+		create := make([]int, 0, len(batch.items))
+		if loader.upsertField == "" {
+			for i := range batch.items {
+				create = append(create, i)
+			}
+		} else {
+			values := make([]any, len(batch.items))
+			for i, item := range batch.items {
+				value, err := itemField(item, loader.upsertField)
+				if err != nil {
+					fail(i, err)
+					continue
+				}
+				values[i] = value
+			}
+			var lookup []any
+			for _, value := range values {
+				if value != nil {
+					lookup = append(lookup, value)
+				}
+			}
+
+			var keys map[string]string
+			if len(lookup) > 0 {
+				var err error
+				keys, err = loader.items.lookupKeys(ctx, loader.upsertField, lookup)
+				if err != nil {
+					for i, value := range values {
+						if value != nil {
+							fail(i, err)
+						}
+					}
+					values = nil
+				}
+			}
+			for i, value := range values {
+				if value == nil {
+					continue
+				}
+				id, ok := keys[fmt.Sprint(value)]
+				if !ok {
+					create = append(create, i)
+					continue
+				}
+				if _, err := loader.items.Update(ctx, id, batch.items[i]); err != nil {
+					fail(i, err)
+					continue
+				}
+				updated++
+			}
+		}
+
+		if len(create) > 0 {
+			c, u, errs := loader.create(ctx, batch.items, create)
+			created += c
+			updated += u
+			for i, err := range errs {
+				fail(i, err)
+			}
+		}
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.report.Created += created
+	state.report.Updated += updated
+	state.report.Failed = append(state.report.Failed, failures...)
+	state.progress.Processed += len(batch.items)
+	state.progress.Created += created
+	state.progress.Updated += updated
+	state.progress.Failed += len(failures)
+	if loader.progress != nil {
+		loader.progress(state.progress)
+	}
+}
+
+// create sends the items of the indexes together. If the request fails the items are sent one by one to know
+// exactly which of them are the problem. When upserting, the items created by another process after the lookup are
+// updated instead. It returns the number of created and updated items and the errors by index.
+func (loader *BulkLoader[T]) create(ctx context.Context, items []*T, indexes []int) (int, int, map[int]error) {
+	create := make([]*T, len(indexes))
+	for i, idx := range indexes {
+		create[i] = items[idx]
+	}
+	err := loader.items.itemsdo(ctx, http.MethodPost, loader.items.c.urlf("/items/%s", loader.items.collection), create, nil)
+	if err == nil {
+		return len(indexes), 0, nil
+	}
+
+	var created, updated int
+	errs := make(map[int]error)
+	for _, idx := range indexes {
+		if loader.upsertField == "" {
+			if _, err := loader.items.Create(ctx, items[idx]); err != nil {
+				errs[idx] = err
+				continue
+			}
+			created++
+			continue
+		}
+
+		_, isNew, err := loader.items.upsert(ctx, loader.upsertField, items[idx])
+		if err != nil {
+			errs[idx] = err
+			continue
+		}
+		if isNew {
+			created++
+		} else {
+			updated++
+		}
+	}
+	return created, updated, errs
+}
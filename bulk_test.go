@@ -0,0 +1,225 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// regimesServer simulates a collection of regimes with a unique code.
+type regimesServer struct {
+	mu      sync.Mutex
+	byCode  map[string]*Regime
+	creates int
+	updates int
+
+	// staleLookups is the number of lookups that will not find any item, to simulate items created concurrently.
+	staleLookups int
+}
+
+func newRegimesServer(t *testing.T, existing ...*Regime) (*regimesServer, *httptest.Server) {
+	rs := &regimesServer{byCode: make(map[string]*Regime)}
+	for _, regime := range existing {
+		rs.byCode[regime.Code] = regime
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rs.mu.Lock()
+		defer rs.mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/fields/regimes":
+			fmt.Fprint(w, `{"data": [{"collection": "regimes", "field": "id", "type": "uuid", "schema": {"is_primary_key": true}}, {"collection": "regimes", "field": "code", "type": "string"}]}`)
+
+		case r.Method == http.MethodGet && r.URL.Path == "/items/regimes":
+			filter, err := ParseFilter([]byte(r.URL.Query().Get("filter")))
+			require.NoError(t, err)
+			in := filter.(filterOperator)
+			var data []map[string]string
+			if rs.staleLookups > 0 {
+				rs.staleLookups--
+				in.value = []any{}
+			}
+			for _, v := range in.value.([]any) {
+				for _, regime := range rs.byCode {
+					if (in.field == "code" && regime.Code == v) || (in.field == "id" && regime.ID == v) {
+						data = append(data, map[string]string{"id": regime.ID, "code": regime.Code})
+					}
+				}
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+
+		case r.Method == http.MethodPost && r.URL.Path == "/items/regimes":
+			var create []*Regime
+			var raw json.RawMessage
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&raw))
+			if raw[0] == '[' {
+				require.NoError(t, json.Unmarshal(raw, &create))
+			} else {
+				var regime Regime
+				require.NoError(t, json.Unmarshal(raw, &regime))
+				create = []*Regime{&regime}
+			}
+			for _, regime := range create {
+				if _, ok := rs.byCode[regime.Code]; ok || regime.Status == "invalid" {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"errors": [{"message": "Invalid.", "extensions": {"code": "RECORD_NOT_UNIQUE"}}]}`)
+					return
+				}
+			}
+			for _, regime := range create {
+				regime.ID = "id-" + regime.Code
+				rs.byCode[regime.Code] = regime
+				rs.creates++
+			}
+			if raw[0] == '[' {
+				require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": create}))
+			} else {
+				require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": create[0]}))
+			}
+
+		case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/items/regimes/"):
+			id := strings.TrimPrefix(r.URL.Path, "/items/regimes/")
+			var update Regime
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+			for _, regime := range rs.byCode {
+				if regime.ID == id {
+					regime.Status = update.Status
+					rs.updates++
+					require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": regime}))
+					return
+				}
+			}
+			w.WriteHeader(http.StatusForbidden)
+
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	return rs, s
+}
+
+func TestBulkLoaderCancelledReportsPending(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		<-release
+	}))
+	defer s.Close()
+	defer close(release)
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	src := make(chan *Regime, 10)
+	for i := range 10 {
+		src <- &Regime{Code: fmt.Sprintf("code-%03d", i)}
+	}
+	close(src)
+	loader := NewBulkLoader(items, WithWorkers(1), WithBulkBatchSize(2))
+	report, err := loader.LoadChan(ctx, src)
+	require.ErrorIs(t, err, context.Canceled)
+
+	var indexes []int
+	for _, failure := range report.Failed {
+		require.ErrorIs(t, failure, context.Canceled)
+		indexes = append(indexes, failure.Index)
+	}
+	require.Zero(t, report.Created)
+	require.Len(t, indexes, 10-len(src))
+	require.Subset(t, indexes, []int{0, 1, 2, 3})
+}
+
+func regimesSeq(n int, status func(i int) string) func(yield func(*Regime) bool) {
+	return func(yield func(*Regime) bool) {
+		for i := range n {
+			if !yield(&Regime{Code: fmt.Sprintf("code-%03d", i), Status: status(i)}) {
+				return
+			}
+		}
+	}
+}
+
+func TestBulkLoaderCreate(t *testing.T) {
+	rs, s := newRegimesServer(t)
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	var progress []BulkProgress
+	loader := NewBulkLoader(items, WithWorkers(3), WithBulkBatchSize(10), WithProgress(func(p BulkProgress) {
+		progress = append(progress, p)
+	}))
+	report, err := loader.Load(context.Background(), regimesSeq(95, func(i int) string {
+		if i == 7 || i == 42 {
+			return "invalid"
+		}
+		return "published"
+	}))
+	require.NoError(t, err)
+
+	require.Equal(t, 93, report.Created)
+	require.Zero(t, report.Updated)
+	require.Len(t, report.Failed, 2)
+	require.Equal(t, 7, report.Failed[0].Index)
+	require.Equal(t, "code-007", report.Failed[0].Item.Code)
+	require.ErrorIs(t, report.Failed[0].Err, ErrorCodeRecordNotUnique)
+	require.Equal(t, 42, report.Failed[1].Index)
+
+	require.Len(t, progress, 10)
+	require.Equal(t, BulkProgress{Processed: 95, Created: 93, Failed: 2}, progress[len(progress)-1])
+	require.Equal(t, 93, rs.creates)
+}
+
+func TestBulkLoaderUpsert(t *testing.T) {
+	rs, s := newRegimesServer(t,
+		&Regime{ID: "existing-1", Code: "code-001", Status: "draft"},
+		&Regime{ID: "existing-2", Code: "code-004", Status: "draft"},
+	)
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	ch := make(chan *Regime)
+	go func() {
+		defer close(ch)
+		for regime := range regimesSeq(6, func(int) string { return "published" }) {
+			ch <- regime
+		}
+	}()
+	report, err := NewBulkLoader(items, WithBulkBatchSize(4), WithUpsertField("code")).LoadChan(context.Background(), ch)
+	require.NoError(t, err)
+	require.Empty(t, report.Failed)
+	require.Equal(t, 4, report.Created)
+	require.Equal(t, 2, report.Updated)
+
+	require.Equal(t, 2, rs.updates)
+	require.Equal(t, "published", rs.byCode["code-001"].Status)
+	require.Equal(t, "existing-2", rs.byCode["code-004"].ID)
+	codes := make([]string, 0, len(rs.byCode))
+	for code := range rs.byCode {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	require.Equal(t, []string{"code-000", "code-001", "code-002", "code-003", "code-004", "code-005"}, codes)
+}
+
+func TestBulkLoaderCancelled(t *testing.T) {
+	_, s := newRegimesServer(t)
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	loader := NewBulkLoader(items, WithWorkers(1), WithBulkBatchSize(5), WithProgress(func(p BulkProgress) {
+		if p.Processed >= 10 {
+			cancel()
+		}
+	}))
+	report, err := loader.Load(ctx, regimesSeq(100, func(int) string { return "published" }))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, report.Created, 100)
+}
@@ -3,11 +3,14 @@ package directus
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Client keeps a connection to a Directus instance.
@@ -16,6 +19,8 @@ type Client struct {
 	CustomTranslations *ResourceClient[CustomTranslation, string]
 	Dashboards         *ResourceClient[Dashboard, string]
 	Files              *ResourceClient[File, string]
+	Uploads            *clientUploads
+	Assets             *clientAssets
 	Flows              *ResourceClient[Flow, string]
 	Folders            *ResourceClient[Folder, string]
 	Operations         *ResourceClient[Operation, string]
@@ -29,10 +34,20 @@ type Client struct {
 	Relations          *clientRelations
 	Server             *clientServer
 	Settings           *clientSettings
+	Auth               *clientAuth
+	Schema             *clientSchema
 
-	instance, token string
-	logger          *slog.Logger
-	bodyLogger      bool
+	instance    string
+	logger      *slog.Logger
+	bodyLogger  bool
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     time.Duration
+	middlewares []Middleware
+	retry       *RetryPolicy
+
+	tokensMu sync.RWMutex
+	tokens   TokenSource
 }
 
 // ClientOption configures a client when creating it.
@@ -52,16 +67,60 @@ func WithBodyLogger() ClientOption {
 	}
 }
 
-// NewClient creates a new connection to the Directus instance using the static token to authenticate.
+// WithHTTPClient sends the requests with a custom HTTP client instead of the default one. The client is copied, so
+// later changes to it will not affect the Directus client.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(client *Client) {
+		client.httpClient = httpClient
+	}
+}
+
+// WithTransport sends the requests through a custom round tripper. It replaces the transport of the HTTP client
+// configured with WithHTTPClient if both are used.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(client *Client) {
+		client.transport = transport
+	}
+}
+
+// WithTimeout limits the time of each request made to the server, including reading the response body.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(client *Client) {
+		client.timeout = timeout
+	}
+}
+
+// Middleware wraps the round tripper that sends the requests to the server.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to the http.RoundTripper interface to write middlewares easily.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (fn RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+// WithMiddleware adds middlewares that wrap every request sent to the server. The first middleware is the outermost
+// one and will see the request before the rest of them.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(client *Client) {
+		client.middlewares = append(client.middlewares, middlewares...)
+	}
+}
+
+// NewClient creates a new connection to the Directus instance using the static token to authenticate. The token can
+// be empty if the client authenticates later with Auth.Login or uses WithTokenSource.
 func NewClient(instance string, token string, opts ...ClientOption) *Client {
 	client := &Client{
 		instance: strings.TrimRight(instance, "/"),
-		token:    token,
+		tokens:   StaticToken(token),
 		logger:   slog.New(slog.Default().Handler()),
 	}
 	for _, opt := range opts {
 		opt(client)
 	}
+	client.httpClient = client.buildHTTPClient()
 
 	client.Collections = NewResourceClient[Collection, string](client, "collections")
 	client.CustomTranslations = NewResourceClient[CustomTranslation, string](client, "translations")
@@ -80,37 +139,157 @@ func NewClient(instance string, token string, opts ...ClientOption) *Client {
 	client.Relations = &clientRelations{client: client}
 	client.Server = &clientServer{client: client}
 	client.Settings = &clientSettings{client: client}
+	client.Auth = &clientAuth{client: client}
+	client.Uploads = &clientUploads{client: client}
+	client.Assets = &clientAssets{client: client}
+	client.Schema = &clientSchema{client: client}
 
 	return client
 }
 
+func (client *Client) buildHTTPClient() *http.Client {
+	var hc http.Client
+	if client.httpClient != nil {
+		hc = *client.httpClient
+	}
+	if client.transport != nil {
+		hc.Transport = client.transport
+	}
+	if hc.Transport == nil {
+		hc.Transport = http.DefaultTransport
+	}
+	if client.retry != nil {
+		hc.Transport = &retryTransport{next: hc.Transport, policy: *client.retry}
+	}
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		hc.Transport = client.middlewares[i](hc.Transport)
+	}
+	if client.timeout > 0 {
+		hc.Timeout = client.timeout
+	}
+	return &hc
+}
+
 func (client *Client) urlf(format string, a ...interface{}) string {
 	return fmt.Sprintf("%s%s", client.instance, fmt.Sprintf(format, a...))
 }
 
+func (client *Client) tokenSource() TokenSource {
+	client.tokensMu.RLock()
+	defer client.tokensMu.RUnlock()
+	return client.tokens
+}
+
+func (client *Client) setTokenSource(tokens TokenSource) {
+	client.tokensMu.Lock()
+	defer client.tokensMu.Unlock()
+	client.tokens = tokens
+}
+
 func (client *Client) sendRequest(req *http.Request, dest interface{}) error {
+	return client.authenticated(req, func(req *http.Request, token string) error {
+		return client.sendRequestToken(req, token, dest)
+	})
+}
+
+// openRequest sends the request and returns the response to read the body as a stream. The caller should close it.
+func (client *Client) openRequest(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	err := client.authenticated(req, func(req *http.Request, token string) error {
+		var err error
+		resp, err = client.openRequestToken(req, token)
+		return err
+	})
+	return resp, err
+}
+
+// authenticated sends the request with the current token. If the server rejects it as expired the token is refreshed
+// and the request is sent again only once if it can be repeated.
+func (client *Client) authenticated(req *http.Request, send func(req *http.Request, token string) error) error {
+	tokens := client.tokenSource()
+	token, err := tokens.Token(req.Context())
+	if err != nil {
+		return fmt.Errorf("directus: cannot obtain token: %w", err)
+	}
+
+	err = send(req, token)
+	if !errors.Is(err, ErrorCodeTokenExpired) {
+		return err
+	}
+
+	refresher, ok := tokens.(tokenRefresher)
+	if !ok || (req.Body != nil && req.GetBody == nil) {
+		return err
+	}
+	token, err = refresher.refreshExpired(req.Context(), token)
+	if err != nil {
+		return err
+	}
+	retry := req.Clone(req.Context())
+	if req.Body != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return fmt.Errorf("directus: cannot repeat request: %v", err)
+		}
+	}
+	return send(retry, token)
+}
+
+func (client *Client) roundTrip(req *http.Request, token string) (*http.Response, error) {
 	client.logger.Debug("directus request", "method", req.Method, "url", req.URL.String())
-	if client.bodyLogger && req.Body != nil {
+	if req.Body != nil && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	// Streamed bodies like file uploads are not logged to avoid reading them in memory.
+	if client.bodyLogger && req.Body != nil && req.Header.Get("Content-Type") == "application/json" {
 		body, err := io.ReadAll(req.Body)
 		if err != nil {
-			return fmt.Errorf("directus: cannot read request body: %v", err)
+			return nil, fmt.Errorf("directus: cannot read request body: %v", err)
 		}
 		req.Body = io.NopCloser(bytes.NewReader(body))
 		client.logger.Debug(string(body))
 	}
 
-	if req.Body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("directus: request failed: %w", err)
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", client.token))
-	resp, err := http.DefaultClient.Do(req)
+	client.logger.Debug("directus reply", "status", resp.StatusCode)
+
+	return resp, nil
+}
+
+func (client *Client) openRequestToken(req *http.Request, token string) (*http.Response, error) {
+	resp, err := client.roundTrip(req, token)
 	if err != nil {
-		return fmt.Errorf("directus: request failed: %w", err)
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
 	}
 	defer resp.Body.Close()
 
-	client.logger.Debug("directus reply", "status", resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot read response body: %v", err)
+	}
+	if client.bodyLogger {
+		client.logger.Debug(string(body))
+	}
+	return nil, newAPIError(req, resp, body)
+}
+
+func (client *Client) sendRequestToken(req *http.Request, token string, dest interface{}) error {
+	resp, err := client.roundTrip(req, token)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -127,20 +306,11 @@ func (client *Client) sendRequest(req *http.Request, dest interface{}) error {
 	case req.Method == http.MethodDelete && resp.StatusCode == http.StatusNoContent:
 		// Everything is fine.
 
-	case resp.StatusCode == http.StatusBadRequest:
-		var reply errorsReply
-		if err := json.Unmarshal(body, &reply); err == nil && len(reply.Errors) > 0 {
-			return reply.Errors[0]
-		}
-
 	case (req.Method == http.MethodPost || req.Method == http.MethodPatch) && resp.StatusCode == http.StatusNoContent:
 		return ErrEmpty
 
 	default:
-		return &unexpectedStatusError{
-			status: resp.StatusCode,
-			url:    req.URL,
-		}
+		return newAPIError(req, resp, body)
 	}
 
 	if dest != nil && len(body) > 0 {
@@ -155,3 +325,16 @@ func (client *Client) sendRequest(req *http.Request, dest interface{}) error {
 type errorsReply struct {
 	Errors []Error `json:"errors"`
 }
+
+func newAPIError(req *http.Request, resp *http.Response, body []byte) error {
+	apiErr := &APIError{
+		Status: resp.StatusCode,
+		Method: req.Method,
+		URL:    req.URL,
+	}
+	var reply errorsReply
+	if err := json.Unmarshal(body, &reply); err == nil {
+		apiErr.Errors = reply.Errors
+	}
+	return apiErr
+}
@@ -1,9 +1,18 @@
 package directus
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func initClient(t *testing.T) *Client {
@@ -14,3 +23,60 @@ func initClient(t *testing.T) *Client {
 	}
 	return NewClient("http://localhost:8055", os.Getenv("DIRECTUS_TOKEN"), WithLogger(slog.New(handler)), WithBodyLogger())
 }
+
+func TestClientTransport(t *testing.T) {
+	var got *http.Request
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		got = req
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data": {"version": "11.0.2"}}`)),
+			Request:    req,
+		}, nil
+	})
+	client := NewClient("http://directus.example.com", "local-token", WithTransport(transport))
+
+	info, err := client.Server.Info(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "11.0.2", info.Version)
+	require.Equal(t, "http://directus.example.com/server/info", got.URL.String())
+	require.Equal(t, "Bearer local-token", got.Header.Get("Authorization"))
+}
+
+func TestClientMiddlewareOrder(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"data": {"version": "11.0.2"}}`)
+	}))
+	defer s.Close()
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+	client := NewClient(s.URL, "local-token", WithMiddleware(trace("first"), trace("second")), WithMiddleware(trace("third")))
+
+	_, err := client.Server.Info(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second", "third"}, calls)
+}
+
+func TestClientTimeout(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	hc := &http.Client{Transport: http.DefaultTransport}
+	client := NewClient(s.URL, "local-token", WithHTTPClient(hc), WithTimeout(10*time.Millisecond))
+
+	_, err := client.Server.Info(context.Background())
+	require.Error(t, err)
+	require.Zero(t, hc.Timeout)
+}
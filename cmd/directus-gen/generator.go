@@ -0,0 +1,401 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/altipla-consulting/directus-go/v2"
+)
+
+// systemTypes are the system collections that already have a type in the library.
+var systemTypes = map[string]string{
+	"directus_files":   "directus.File",
+	"directus_folders": "directus.Folder",
+	"directus_roles":   "directus.Role",
+	"directus_users":   "directus.User",
+}
+
+// initialisms are written in upper case in the generated names.
+var initialisms = map[string]bool{
+	"api":  true,
+	"html": true,
+	"http": true,
+	"id":   true,
+	"ip":   true,
+	"json": true,
+	"seo":  true,
+	"sku":  true,
+	"sql":  true,
+	"uri":  true,
+	"url":  true,
+	"uuid": true,
+}
+
+type generator struct {
+	pkg         string
+	fields      []*directus.Field
+	relations   []*directus.RelationDefinition
+	collections []string
+
+	buf     bytes.Buffer
+	imports map[string]bool
+
+	// declared are the top level identifiers of the generated code with the names of Directus they come from.
+	declared map[string]string
+}
+
+func generate(pkg string, fields []*directus.Field, relations []*directus.RelationDefinition, collections []string) ([]byte, error) {
+	g := &generator{
+		pkg:         pkg,
+		fields:      fields,
+		relations:   relations,
+		collections: collections,
+		imports:     make(map[string]bool),
+		declared:    make(map[string]string),
+	}
+	return g.generate()
+}
+
+func (g *generator) generate() ([]byte, error) {
+	byCollection := make(map[string][]*directus.Field)
+	for _, field := range g.fields {
+		if !g.selected(field.Collection) {
+			continue
+		}
+		byCollection[field.Collection] = append(byCollection[field.Collection], field)
+	}
+	names := make([]string, 0, len(byCollection))
+	for name := range byCollection {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if err := g.generateCollection(name, byCollection[name]); err != nil {
+			return nil, err
+		}
+	}
+	g.generateManyToAny(byCollection)
+
+	var out bytes.Buffer
+	fmt.Fprintln(&out, "// Code generated by directus-gen. DO NOT EDIT.")
+	fmt.Fprintln(&out)
+	fmt.Fprintf(&out, "package %s\n\n", g.pkg)
+	if len(g.imports) > 0 {
+		// Standard library imports go first in their own group.
+		var std, external []string
+		for imp := range g.imports {
+			if strings.Contains(strings.Split(imp, "/")[0], ".") {
+				external = append(external, imp)
+			} else {
+				std = append(std, imp)
+			}
+		}
+		slices.Sort(std)
+		slices.Sort(external)
+		fmt.Fprintln(&out, "import (")
+		for _, imp := range std {
+			fmt.Fprintf(&out, "\t%q\n", imp)
+		}
+		if len(std) > 0 && len(external) > 0 {
+			fmt.Fprintln(&out)
+		}
+		for _, imp := range external {
+			fmt.Fprintf(&out, "\t%q\n", imp)
+		}
+		fmt.Fprintln(&out, ")")
+	}
+	out.Write(g.buf.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("cannot format generated code: %v", err)
+	}
+	return src, nil
+}
+
+// selected returns true if the collection should be generated. System collections are only generated when
+// explicitly requested.
+func (g *generator) selected(collection string) bool {
+	if len(g.collections) > 0 {
+		return slices.Contains(g.collections, collection)
+	}
+	return !strings.HasPrefix(collection, "directus_")
+}
+
+type structField struct {
+	name    string
+	goType  string
+	tag     string
+	choices *directus.FieldChoices
+}
+
+func (g *generator) generateCollection(collection string, fields []*directus.Field) error {
+	typeName := g.typeName(collection)
+	if err := g.declare(typeName, fmt.Sprintf("the collection %s", collection)); err != nil {
+		return err
+	}
+
+	var members []*structField
+	memberFields := make(map[string]string)
+	for _, field := range fields {
+		goType, ok := g.fieldType(field)
+		if !ok {
+			continue
+		}
+		member := &structField{
+			name:   exportedName(field.Field),
+			goType: goType,
+			tag:    field.Field,
+		}
+		if field.Schema != nil && field.Schema.IsPrimaryKey || strings.HasPrefix(goType, "[]") {
+			member.tag += ",omitempty"
+		}
+		source := fmt.Sprintf("the field %s.%s", collection, field.Field)
+		if previous, ok := memberFields[member.name]; ok {
+			return fmt.Errorf("cannot generate %s.%s for %s, it is already used by %s", typeName, member.name, source, previous)
+		}
+		memberFields[member.name] = source
+		if err := g.declare(typeName+"Field"+member.name, source); err != nil {
+			return err
+		}
+		if choices := stringChoices(field); choices != nil {
+			member.choices = choices
+			choiceType := typeName + member.name
+			member.goType = strings.Replace(member.goType, "string", choiceType, 1)
+			if err := g.declare(choiceType, fmt.Sprintf("the choices of %s", source)); err != nil {
+				return err
+			}
+			for _, value := range choiceValues(choices) {
+				if err := g.declare(choiceType+exportedName(value), fmt.Sprintf("the choice %q of %s", value, source)); err != nil {
+					return err
+				}
+			}
+		}
+		members = append(members, member)
+	}
+
+	fmt.Fprintf(&g.buf, "\n// %s is an item of the %s collection.\n", typeName, collection)
+	fmt.Fprintf(&g.buf, "type %s struct {\n", typeName)
+	for _, member := range members {
+		fmt.Fprintf(&g.buf, "\t%s %s `json:\"%s\"`\n", member.name, member.goType, member.tag)
+	}
+	fmt.Fprintln(&g.buf, "}")
+
+	fmt.Fprintf(&g.buf, "\n// Field names of the %s collection.\n", collection)
+	fmt.Fprintln(&g.buf, "const (")
+	for _, member := range members {
+		fmt.Fprintf(&g.buf, "\t%sField%s = %q\n", typeName, member.name, strings.Split(member.tag, ",")[0])
+	}
+	fmt.Fprintln(&g.buf, ")")
+
+	for _, member := range members {
+		if member.choices == nil {
+			continue
+		}
+		choiceType := typeName + member.name
+		fmt.Fprintf(&g.buf, "\n// %s are the allowed values of the field %s.%s.\n", choiceType, collection, member.tag)
+		fmt.Fprintf(&g.buf, "type %s string\n\n", choiceType)
+		fmt.Fprintln(&g.buf, "const (")
+		for _, value := range choiceValues(member.choices) {
+			fmt.Fprintf(&g.buf, "\t%s%s %s = %q\n", choiceType, exportedName(value), choiceType, value)
+		}
+		fmt.Fprintln(&g.buf, ")")
+	}
+
+	return nil
+}
+
+// declare reserves a top level identifier of the generated code. Different names in Directus can be converted to the
+// same identifier, and the code would not compile if both of them were generated.
+func (g *generator) declare(name, source string) error {
+	if previous, ok := g.declared[name]; ok {
+		return fmt.Errorf("cannot generate %s for %s, it is already used by %s", name, source, previous)
+	}
+	g.declared[name] = source
+	return nil
+}
+
+// generateManyToAny registers the generated types of the collections that can be the target of a many-to-any
+// relation to decode them automatically.
+func (g *generator) generateManyToAny(generated map[string][]*directus.Field) {
+	var collections []string
+	for _, relation := range g.relations {
+		allowed, _ := relation.Meta.Unknown["one_allowed_collections"].([]any)
+		for _, collection := range allowed {
+			name, _ := collection.(string)
+			if len(generated[name]) > 0 && !slices.Contains(collections, name) {
+				collections = append(collections, name)
+			}
+		}
+	}
+	if len(collections) == 0 {
+		return
+	}
+	slices.Sort(collections)
+
+	g.imports["github.com/altipla-consulting/directus-go/v2"] = true
+	fmt.Fprintln(&g.buf, "\nfunc init() {")
+	for _, collection := range collections {
+		fmt.Fprintf(&g.buf, "\tdirectus.RegisterManyToAny[%s](%q)\n", g.typeName(collection), collection)
+	}
+	fmt.Fprintln(&g.buf, "}")
+}
+
+// fieldType returns the Go type of a field. It returns false if the field has no data and should not be generated.
+func (g *generator) fieldType(field *directus.Field) (string, bool) {
+	if target, ok := g.manyToOne(field); ok {
+		g.imports["github.com/altipla-consulting/directus-go/v2"] = true
+		return fmt.Sprintf("directus.Relation[%s]", g.relatedType(target)), true
+	}
+	if field.Meta.HasSpecial(directus.FieldSpecialManyToAny) {
+		g.imports["github.com/altipla-consulting/directus-go/v2"] = true
+		return "[]directus.ManyToAny", true
+	}
+	if target, ok := g.oneToMany(field); ok {
+		g.imports["github.com/altipla-consulting/directus-go/v2"] = true
+		return fmt.Sprintf("[]directus.Relation[%s]", g.relatedType(target)), true
+	}
+
+	var goType string
+	switch field.Type {
+	// Dates without timezone are returned in formats that cannot be decoded in time.Time.
+	case "string", "text", "uuid", "hash", "decimal", "date", "time", "dateTime", "geometry", "geometry.Point", "geometry.LineString",
+		"geometry.Polygon", "geometry.MultiPoint", "geometry.MultiLineString", "geometry.MultiPolygon":
+		goType = "string"
+	case "integer", "bigInteger":
+		goType = "int64"
+	case "float":
+		goType = "float64"
+	case "boolean":
+		goType = "bool"
+	case "timestamp":
+		g.imports["time"] = true
+		goType = "time.Time"
+	case "csv":
+		return "[]string", true
+	case "json":
+		g.imports["encoding/json"] = true
+		return "json.RawMessage", true
+	default:
+		// Aliases without relations only change the presentation in the app.
+		return "", false
+	}
+
+	if field.Schema != nil && field.Schema.IsNullable && !field.Schema.IsPrimaryKey {
+		g.imports["github.com/altipla-consulting/directus-go/v2"] = true
+		goType = fmt.Sprintf("directus.Nullable[%s]", goType)
+	}
+	return goType, true
+}
+
+// manyToOne returns the related collection if the field points to a single item of another collection.
+func (g *generator) manyToOne(field *directus.Field) (string, bool) {
+	for _, relation := range g.relations {
+		if relation.Collection == field.Collection && relation.Field == field.Field && relation.RelatedCollection != "" {
+			return relation.RelatedCollection, true
+		}
+	}
+	return "", false
+}
+
+// oneToMany returns the collection of the items that point to the field. For many to many relations and translations
+// it is the junction collection.
+func (g *generator) oneToMany(field *directus.Field) (string, bool) {
+	for _, relation := range g.relations {
+		oneField, _ := relation.Meta.Unknown["one_field"].(string)
+		if relation.RelatedCollection == field.Collection && oneField == field.Field {
+			return relation.Collection, true
+		}
+	}
+	return "", false
+}
+
+func (g *generator) relatedType(collection string) string {
+	if name, ok := systemTypes[collection]; ok {
+		g.imports["github.com/altipla-consulting/directus-go/v2"] = true
+		return name
+	}
+	for _, field := range g.fields {
+		if field.Collection == collection && g.selected(collection) {
+			return g.typeName(collection)
+		}
+	}
+	// The related collection is not generated.
+	return "map[string]any"
+}
+
+func (g *generator) typeName(collection string) string {
+	return exportedName(singular(collection))
+}
+
+func stringChoices(field *directus.Field) *directus.FieldChoices {
+	if field.Meta.Options == nil || !slices.Contains([]string{"string", "text"}, string(field.Type)) {
+		return nil
+	}
+	choices := &field.Meta.Options.Choices
+	if len(choices.Choices) == 0 && len(choices.Values) == 0 {
+		return nil
+	}
+	for _, choice := range choices.Choices {
+		if _, ok := choice.Value.(string); !ok {
+			return nil
+		}
+	}
+	return choices
+}
+
+func choiceValues(choices *directus.FieldChoices) []string {
+	var values []string
+	for _, choice := range choices.Choices {
+		values = append(values, choice.Value.(string))
+	}
+	for _, value := range choices.Values {
+		values = append(values, fmt.Sprint(value))
+	}
+	return values
+}
+
+// exportedName converts a snake case name to an exported Go identifier.
+func exportedName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var sb strings.Builder
+	for _, word := range words {
+		if initialisms[strings.ToLower(word)] {
+			sb.WriteString(strings.ToUpper(word))
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	if sb.Len() == 0 {
+		return "Empty"
+	}
+	s := sb.String()
+	if unicode.IsDigit([]rune(s)[0]) {
+		s = "N" + s
+	}
+	return s
+}
+
+// singular removes the plural suffix of the last word of a collection name.
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "sses"), strings.HasSuffix(name, "xes"), strings.HasSuffix(name, "ches"), strings.HasSuffix(name, "shes"):
+		return strings.TrimSuffix(name, "es")
+	case strings.HasSuffix(name, "ss"):
+		return name
+	case strings.HasSuffix(name, "s"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name
+}
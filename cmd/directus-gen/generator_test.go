@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/altipla-consulting/directus-go/v2"
+)
+
+var flagUpdate = flag.Bool("update", false, "Update the golden files with the generated code.")
+
+func TestGenerateSnapshot(t *testing.T) {
+	snapshot, err := readSnapshot("testdata/snapshot.json")
+	require.NoError(t, err)
+
+	src, err := generate("models", snapshot.Fields, snapshot.Relations, nil)
+	require.NoError(t, err)
+
+	if *flagUpdate {
+		require.NoError(t, os.WriteFile("testdata/models.go.golden", src, 0644))
+	}
+	golden, err := os.ReadFile("testdata/models.go.golden")
+	require.NoError(t, err)
+	require.Equal(t, string(golden), string(src))
+}
+
+func TestGenerateCollections(t *testing.T) {
+	snapshot, err := readSnapshot("testdata/snapshot.json")
+	require.NoError(t, err)
+
+	src, err := generate("models", snapshot.Fields, snapshot.Relations, []string{"regimes", "directus_users"})
+	require.NoError(t, err)
+
+	require.Contains(t, string(src), "type Regime struct {")
+	require.Contains(t, string(src), "type DirectusUser struct {")
+	require.NotContains(t, string(src), "type Hotel struct {")
+	require.Contains(t, string(src), "Hotel        directus.Relation[map[string]any]")
+}
+
+func TestGenerateDecodeDates(t *testing.T) {
+	goTypes := map[string]reflect.Type{
+		"string":    reflect.TypeFor[string](),
+		"time.Time": reflect.TypeFor[time.Time](),
+	}
+	tests := []struct {
+		fieldType directus.FieldType
+		value     string
+	}{
+		{"dateTime", `"2024-03-01T10:30:00"`},
+		{"timestamp", `"2024-03-01T10:30:00.000Z"`},
+		{"date", `"2024-03-01"`},
+		{"time", `"10:30:00"`},
+	}
+	g := &generator{imports: make(map[string]bool)}
+	for _, test := range tests {
+		goType, ok := g.fieldType(&directus.Field{Collection: "regimes", Field: "valid_from", Type: test.fieldType})
+		require.True(t, ok)
+		typ, ok := goTypes[goType]
+		require.True(t, ok, goType)
+
+		value := reflect.New(typ)
+		require.NoError(t, json.Unmarshal([]byte(test.value), value.Interface()), test.fieldType)
+	}
+}
+
+func TestGenerateDuplicatedNames(t *testing.T) {
+	choices := func(values ...any) directus.FieldMeta {
+		return directus.FieldMeta{Options: &directus.FieldOptions{Choices: directus.FieldChoices{Values: values}}}
+	}
+	tests := []struct {
+		name   string
+		fields []*directus.Field
+		err    string
+	}{
+		{
+			name: "choices",
+			fields: []*directus.Field{
+				{Collection: "regimes", Field: "status", Type: "string", Meta: choices("foo-bar", "foo_bar")},
+			},
+			err: `cannot generate RegimeStatusFooBar for the choice "foo_bar" of the field regimes.status, it is already used by the choice "foo-bar" of the field regimes.status`,
+		},
+		{
+			name: "choices type",
+			fields: []*directus.Field{
+				{Collection: "regime_kinds", Field: "code", Type: "string"},
+				{Collection: "regimes", Field: "kind", Type: "string", Meta: choices("hotel")},
+			},
+			err: "cannot generate RegimeKind for the choices of the field regimes.kind, it is already used by the collection regime_kinds",
+		},
+		{
+			name: "collections",
+			fields: []*directus.Field{
+				{Collection: "regime", Field: "code", Type: "string"},
+				{Collection: "regimes", Field: "code", Type: "string"},
+			},
+			err: "cannot generate Regime for the collection regimes, it is already used by the collection regime",
+		},
+		{
+			name: "fields",
+			fields: []*directus.Field{
+				{Collection: "regimes", Field: "display-name", Type: "string"},
+				{Collection: "regimes", Field: "display_name", Type: "string"},
+			},
+			err: "cannot generate Regime.DisplayName for the field regimes.display_name, it is already used by the field regimes.display-name",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := generate("models", test.fields, nil, nil)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestExportedName(t *testing.T) {
+	require.Equal(t, "ID", exportedName("id"))
+	require.Equal(t, "HotelID", exportedName("hotel_id"))
+	require.Equal(t, "ImageURL", exportedName("image-url"))
+	require.Equal(t, "DisplayName", exportedName("display name"))
+	require.Equal(t, "N3Stars", exportedName("3_stars"))
+}
+
+func TestSingular(t *testing.T) {
+	require.Equal(t, "regime", singular("regimes"))
+	require.Equal(t, "category", singular("categories"))
+	require.Equal(t, "class", singular("classes"))
+	require.Equal(t, "box", singular("boxes"))
+	require.Equal(t, "address", singular("address"))
+	require.Equal(t, "news_item", singular("news_item"))
+}
@@ -0,0 +1,102 @@
+// Command directus-gen generates Go structs for the collections of a Directus instance.
+//
+// The schema is read from a live instance:
+//
+//	directus-gen -url https://directus.example.com -token $DIRECTUS_TOKEN -package models -out models/directus.go
+//
+// Or from a snapshot file exported with `directus schema snapshot --format json`:
+//
+//	directus-gen -snapshot snapshot.json -package models -out models/directus.go
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/altipla-consulting/directus-go/v2"
+)
+
+var (
+	flagURL         = flag.String("url", "", "Address of the Directus instance to read the schema from.")
+	flagToken       = flag.String("token", os.Getenv("DIRECTUS_TOKEN"), "Static token to authenticate with the instance. By default it uses the DIRECTUS_TOKEN environment variable.")
+	flagSnapshot    = flag.String("snapshot", "", "JSON schema snapshot file to read the schema from instead of a live instance.")
+	flagPackage     = flag.String("package", "models", "Package name of the generated file.")
+	flagOut         = flag.String("out", "", "Output file. By default it prints the code to stdout.")
+	flagCollections = flag.String("collections", "", "Comma separated list of collections to generate. By default it generates all the non-system collections.")
+)
+
+func main() {
+	flag.Parse()
+	if err := run(context.Background()); err != nil {
+		fmt.Fprintln(os.Stderr, "directus-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	var fields []*directus.Field
+	var relations []*directus.RelationDefinition
+	switch {
+	case *flagSnapshot != "":
+		snapshot, err := readSnapshot(*flagSnapshot)
+		if err != nil {
+			return err
+		}
+		fields, relations = snapshot.Fields, snapshot.Relations
+
+	case *flagURL != "":
+		client := directus.NewClient(*flagURL, *flagToken)
+		var err error
+		fields, err = client.Fields.List(ctx)
+		if err != nil {
+			return err
+		}
+		relations, err = client.Relations.List(ctx)
+		if err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("one of -url or -snapshot is required")
+	}
+
+	var collections []string
+	if *flagCollections != "" {
+		collections = strings.Split(*flagCollections, ",")
+	}
+	src, err := generate(*flagPackage, fields, relations, collections)
+	if err != nil {
+		return err
+	}
+
+	if *flagOut == "" {
+		_, err := os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(*flagOut, src, 0644)
+}
+
+func readSnapshot(filename string) (*directus.SchemaSnapshot, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accept the snapshot as returned by the API too, inside the data key.
+	reply := struct {
+		Data *directus.SchemaSnapshot `json:"data"`
+	}{}
+	if err := json.Unmarshal(content, &reply); err == nil && reply.Data != nil {
+		return reply.Data, nil
+	}
+
+	snapshot := new(directus.SchemaSnapshot)
+	if err := json.Unmarshal(content, snapshot); err != nil {
+		return nil, fmt.Errorf("cannot decode snapshot %s: %v", filename, err)
+	}
+	return snapshot, nil
+}
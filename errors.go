@@ -1,9 +1,13 @@
 package directus
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
+
+	"github.com/perimeterx/marshmallow"
 )
 
 var (
@@ -12,15 +16,51 @@ var (
 
 	// ErrIEmpty is returned when the item is returned empty.
 	ErrEmpty = errors.New("directus: empty")
+
+	// ErrNoMorePages is returned by a pager when all the pages have been read.
+	ErrNoMorePages = errors.New("directus: no more pages")
+
+	// ErrLoggedOut is returned when using a session after logging out.
+	ErrLoggedOut = errors.New("directus: logged out")
 )
 
-type unexpectedStatusError struct {
-	status int
-	url    *url.URL
+// APIError is returned when the server replies with an error status code. It contains all the errors sent by
+// Directus in the body, if any. Use errors.Is with an ErrorCode to check for a specific error.
+type APIError struct {
+	Status int
+	Method string
+	URL    *url.URL
+	Errors []Error
+}
+
+func (e *APIError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("directus: unexpected status code %v for %s %q", e.Status, e.Method, e.URL.String())
+	}
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = strings.TrimPrefix(err.Error(), "directus: ")
+	}
+	return fmt.Sprintf("directus: status code %v for %s %q: %s", e.Status, e.Method, e.URL.String(), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the individual errors sent by the server.
+func (e *APIError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
 }
 
-func (e *unexpectedStatusError) Error() string {
-	return fmt.Sprintf("directus: unexpected status code %v for url %q", e.status, e.url.String())
+// HasCode reports whether any of the errors sent by the server has the code.
+func (e *APIError) HasCode(code ErrorCode) bool {
+	for _, err := range e.Errors {
+		if err.Extensions.Code == code {
+			return true
+		}
+	}
+	return false
 }
 
 type Error struct {
@@ -30,18 +70,113 @@ type Error struct {
 
 func (e Error) Error() string {
 	if e.Extensions.Code != "" {
-		return fmt.Sprintf("directus: %s (code: %s)", e.Message, e.Extensions.Code)
+		return fmt.Sprintf("directus: %s (code: %s)", e.Message, string(e.Extensions.Code))
 	}
 	return fmt.Sprintf("directus: %s", e.Message)
 }
 
+// Is matches the error against an ErrorCode to use it with errors.Is.
+func (e Error) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && e.Extensions.Code == code
+}
+
 type ErrorExtensions struct {
-	Code ErrorCode `json:"code"`
+	Code       ErrorCode `json:"code"`
+	Collection string    `json:"collection,omitempty"`
+	Field      string    `json:"field,omitempty"`
+	Type       string    `json:"type,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	Valid      any       `json:"valid,omitempty"`
+	Invalid    any       `json:"invalid,omitempty"`
+
+	Unknown map[string]any `json:"-"`
 }
 
+func (extensions *ErrorExtensions) UnmarshalJSON(data []byte) error {
+	values, err := marshmallow.Unmarshal(data, extensions, marshmallow.WithExcludeKnownFieldsFromMap(true))
+	if err != nil {
+		return err
+	}
+	extensions.Unknown = values
+	return nil
+}
+
+// ErrorCode is the code that Directus assigns to each kind of error. It can be used as the target of errors.Is to
+// check the errors returned by the client.
 type ErrorCode string
 
+func (code ErrorCode) Error() string {
+	return fmt.Sprintf("directus: error code %s", string(code))
+}
+
+// UnmarshalJSON is required to decode the code inside ErrorExtensions, marshmallow ignores the string types that do
+// not implement it.
+func (code *ErrorCode) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	*code = ErrorCode(str)
+	return nil
+}
+
+// Error codes returned by Directus. They have the type ErrorCode to be used as targets of errors.Is; convert them with
+// string() to compare them with plain strings.
 const (
-	ErrorCodeRecordNotUnique   = "RECORD_NOT_UNIQUE"
-	ErrorCodeInvalidForeignKey = "INVALID_FOREIGN_KEY"
+	ErrorCodeRecordNotUnique      ErrorCode = "RECORD_NOT_UNIQUE"
+	ErrorCodeInvalidForeignKey    ErrorCode = "INVALID_FOREIGN_KEY"
+	ErrorCodeTokenExpired         ErrorCode = "TOKEN_EXPIRED"
+	ErrorCodeInvalidToken         ErrorCode = "INVALID_TOKEN"
+	ErrorCodeInvalidCredentials   ErrorCode = "INVALID_CREDENTIALS"
+	ErrorCodeInvalidOTP           ErrorCode = "INVALID_OTP"
+	ErrorCodeUserSuspended        ErrorCode = "USER_SUSPENDED"
+	ErrorCodeForbidden            ErrorCode = "FORBIDDEN"
+	ErrorCodeRouteNotFound        ErrorCode = "ROUTE_NOT_FOUND"
+	ErrorCodeInvalidPayload       ErrorCode = "INVALID_PAYLOAD"
+	ErrorCodeInvalidQuery         ErrorCode = "INVALID_QUERY"
+	ErrorCodeFailedValidation     ErrorCode = "FAILED_VALIDATION"
+	ErrorCodeValueTooLong         ErrorCode = "VALUE_TOO_LONG"
+	ErrorCodeValueOutOfRange      ErrorCode = "VALUE_OUT_OF_RANGE"
+	ErrorCodeNotNullViolation     ErrorCode = "NOT_NULL_VIOLATION"
+	ErrorCodeContainsNullValues   ErrorCode = "CONTAINS_NULL_VALUES"
+	ErrorCodeUnsupportedMediaType ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	ErrorCodeRequestsExceeded     ErrorCode = "REQUESTS_EXCEEDED"
+	ErrorCodeServiceUnavailable   ErrorCode = "SERVICE_UNAVAILABLE"
+	ErrorCodeInternal             ErrorCode = "INTERNAL"
 )
+
+// BatchError is returned when some of the chunks of a batch operation fail. The rest of the chunks are applied
+// normally.
+type BatchError struct {
+	Chunks []*ChunkError
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("directus: %d batch chunks failed, first one: %v", len(e.Chunks), e.Chunks[0])
+}
+
+// Unwrap returns the errors of each failed chunk.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Chunks))
+	for i, chunk := range e.Chunks {
+		errs[i] = chunk
+	}
+	return errs
+}
+
+// ChunkError is the failure of a single chunk of a batch operation. Offset and Size reference the position of the
+// chunk in the original list of items or keys.
+type ChunkError struct {
+	Offset int
+	Size   int
+	Err    error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("chunk [%d:%d]: %v", e.Offset, e.Offset+e.Size, e.Err)
+}
+
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,100 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorDecodesAllErrors(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `
+			{
+				"errors": [
+					{
+						"message": "Value for field \"code\" in collection \"regimes\" has to be unique.",
+						"extensions": {"code": "RECORD_NOT_UNIQUE", "collection": "regimes", "field": "code", "primaryKey": false}
+					},
+					{
+						"message": "Validation failed for field \"status\".",
+						"extensions": {"code": "FAILED_VALIDATION", "field": "status", "type": "in", "valid": ["draft", "published"], "invalid": "foo"}
+					}
+				]
+			}
+		`)
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	_, err := items.Create(context.Background(), &Regime{Code: "foo", Status: "foo"})
+	require.Error(t, err)
+
+	require.ErrorIs(t, err, ErrorCodeRecordNotUnique)
+	require.ErrorIs(t, err, ErrorCodeFailedValidation)
+	require.NotErrorIs(t, err, ErrorCodeForbidden)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusBadRequest, apiErr.Status)
+	require.Equal(t, http.MethodPost, apiErr.Method)
+	require.Equal(t, "/items/regimes", apiErr.URL.Path)
+	require.Len(t, apiErr.Errors, 2)
+	require.True(t, apiErr.HasCode(ErrorCodeRecordNotUnique))
+
+	require.Equal(t, "regimes", apiErr.Errors[0].Extensions.Collection)
+	require.Equal(t, "code", apiErr.Errors[0].Extensions.Field)
+	require.Equal(t, map[string]any{"primaryKey": false}, apiErr.Errors[0].Extensions.Unknown)
+	require.Equal(t, "in", apiErr.Errors[1].Extensions.Type)
+	require.Equal(t, []any{"draft", "published"}, apiErr.Errors[1].Extensions.Valid)
+	require.Equal(t, "foo", apiErr.Errors[1].Extensions.Invalid)
+
+	var first Error
+	require.True(t, errors.As(err, &first))
+	require.Equal(t, ErrorCodeRecordNotUnique, first.Extensions.Code)
+}
+
+func TestAPIErrorWithoutBody(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	_, err := client.Server.Info(context.Background())
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusInternalServerError, apiErr.Status)
+	require.Empty(t, apiErr.Errors)
+	require.EqualError(t, err, fmt.Sprintf(`directus: unexpected status code 500 for GET "%s/server/info"`, s.URL))
+}
+
+func TestAPIErrorForbidden(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errors": [{"message": "You don't have permission to access this.", "extensions": {"code": "FORBIDDEN"}}]}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	_, err := client.Server.Info(context.Background())
+	require.ErrorIs(t, err, ErrorCodeForbidden)
+	require.EqualError(t, err, fmt.Sprintf(`directus: status code 403 for GET "%s/server/info": You don't have permission to access this. (code: FORBIDDEN)`, s.URL))
+
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+	_, err = items.Get(context.Background(), "foo")
+	require.ErrorIs(t, err, ErrItemNotFound)
+}
+
+func TestErrorCodeDecode(t *testing.T) {
+	var e Error
+	require.NoError(t, json.Unmarshal([]byte(`{"message": "Duplicated.", "extensions": {"code": "RECORD_NOT_UNIQUE", "field": "code"}}`), &e))
+	require.Equal(t, ErrorCodeRecordNotUnique, e.Extensions.Code)
+	require.Equal(t, "RECORD_NOT_UNIQUE", string(e.Extensions.Code))
+}
@@ -28,6 +28,15 @@ const (
 	FieldTypeUUID      FieldType = "uuid"
 )
 
+func (t *FieldType) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	*t = FieldType(str)
+	return nil
+}
+
 type FieldMeta struct {
 	ID     int64      `json:"id,omitempty"`
 	Hidden bool       `json:"hidden"`
@@ -110,6 +119,7 @@ type FieldSpecial string
 
 const (
 	FieldSpecialManyToOne   FieldSpecial = "m2o"
+	FieldSpecialManyToAny   FieldSpecial = "m2a"
 	FieldSpecialDateCreated FieldSpecial = "date-created"
 	FieldSpecialDateUpdated FieldSpecial = "date-updated"
 	FieldSpecialUUID        FieldSpecial = "uuid"
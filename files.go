@@ -0,0 +1,138 @@
+package directus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+)
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// clientUploads sends the contents of the files. The rest of the operations with files are in Client.Files.
+type clientUploads struct {
+	client *Client
+}
+
+// FileUpload contains the metadata of a file sent to the server together with its contents.
+type FileUpload struct {
+	Title            string `json:"title,omitempty"`
+	Description      string `json:"description,omitempty"`
+	Folder           string `json:"folder,omitempty"`
+	Storage          string `json:"storage,omitempty"`
+	FilenameDownload string `json:"filename_download,omitempty"`
+
+	// Type is the MIME type of the contents. By default it is application/octet-stream.
+	Type string `json:"type,omitempty"`
+}
+
+// Create uploads a new file reading its contents from r. The contents are streamed to the server without buffering
+// them in memory.
+func (cu *clientUploads) Create(ctx context.Context, r io.Reader, upload *FileUpload) (*File, error) {
+	return cu.sendFile(ctx, http.MethodPost, cu.client.urlf("/files"), r, upload)
+}
+
+// Replace changes the contents of an existing file, and optionally its metadata, reading the new contents from r.
+func (cu *clientUploads) Replace(ctx context.Context, id string, r io.Reader, upload *FileUpload) (*File, error) {
+	return cu.sendFile(ctx, http.MethodPatch, cu.client.urlf("/files/%s", id), r, upload)
+}
+
+// Import creates a new file downloading its contents from the URL in the server.
+func (cu *clientUploads) Import(ctx context.Context, url string, upload *FileUpload) (*File, error) {
+	request := struct {
+		URL  string      `json:"url"`
+		Data *FileUpload `json:"data,omitempty"`
+	}{
+		URL:  url,
+		Data: upload,
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(request); err != nil {
+		return nil, fmt.Errorf("directus: cannot encode request: %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cu.client.urlf("/files/import"), &buf)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	reply := struct {
+		Data *File `json:"data"`
+	}{}
+	if err := cu.client.sendRequest(req, &reply); err != nil {
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+func (cu *clientUploads) sendFile(ctx context.Context, method, url string, r io.Reader, upload *FileUpload) (*File, error) {
+	if upload == nil {
+		upload = new(FileUpload)
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeFileMultipart(mw, r, upload))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, pr)
+	if err != nil {
+		pr.Close()
+		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	reply := struct {
+		Data *File `json:"data"`
+	}{}
+	if err := cu.client.sendRequest(req, &reply); err != nil {
+		pr.Close()
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+func writeFileMultipart(mw *multipart.Writer, r io.Reader, upload *FileUpload) error {
+	// Directus requires the metadata fields to be sent before the file contents.
+	fields := []struct {
+		name, value string
+	}{
+		{"title", upload.Title},
+		{"description", upload.Description},
+		{"folder", upload.Folder},
+		{"storage", upload.Storage},
+		{"filename_download", upload.FilenameDownload},
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		if err := mw.WriteField(field.name, field.value); err != nil {
+			return fmt.Errorf("directus: cannot write file metadata: %w", err)
+		}
+	}
+
+	filename := upload.FilenameDownload
+	if filename == "" {
+		filename = "file"
+	}
+	contentType := upload.Type
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(filename)))
+	h.Set("Content-Type", contentType)
+	part, err := mw.CreatePart(h)
+	if err != nil {
+		return fmt.Errorf("directus: cannot write file contents: %w", err)
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return fmt.Errorf("directus: cannot write file contents: %w", err)
+	}
+	return mw.Close()
+}
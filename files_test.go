@@ -0,0 +1,107 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesUpload(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/files", r.URL.Path)
+
+		mr, err := r.MultipartReader()
+		require.NoError(t, err)
+
+		var names []string
+		values := make(map[string]string)
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(part)
+			require.NoError(t, err)
+			names = append(names, part.FormName())
+			values[part.FormName()] = string(content)
+			if part.FormName() == "file" {
+				require.Equal(t, "report.txt", part.FileName())
+				require.Equal(t, "text/plain", part.Header.Get("Content-Type"))
+			}
+		}
+		require.Equal(t, []string{"title", "folder", "filename_download", "file"}, names)
+		require.Equal(t, "Report", values["title"])
+		require.Equal(t, "folder-1", values["folder"])
+		require.Equal(t, "report contents", values["file"])
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"data": {"id": "file-1", "title": "Report", "storage": "local", "filename_download": "report.txt"}}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token", WithBodyLogger())
+
+	file, err := client.Uploads.Create(context.Background(), strings.NewReader("report contents"), &FileUpload{
+		Title:            "Report",
+		Folder:           "folder-1",
+		FilenameDownload: "report.txt",
+		Type:             "text/plain",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "file-1", file.ID)
+	require.Equal(t, "Report", file.Title.Value)
+}
+
+func TestFilesReplace(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+		require.Equal(t, "/files/file-1", r.URL.Path)
+
+		f, _, err := r.FormFile("file")
+		require.NoError(t, err)
+		content, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, "new contents", string(content))
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"data": {"id": "file-1", "storage": "local", "filename_download": "file"}}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	file, err := client.Uploads.Replace(context.Background(), "file-1", strings.NewReader("new contents"), nil)
+	require.NoError(t, err)
+	require.Equal(t, "file-1", file.ID)
+}
+
+func TestFilesImport(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/files/import", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, map[string]any{
+			"url":  "https://example.com/logo.png",
+			"data": map[string]any{"title": "Logo"},
+		}, body)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"data": {"id": "file-2", "storage": "local", "filename_download": "logo.png"}}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	file, err := client.Uploads.Import(context.Background(), "https://example.com/logo.png", &FileUpload{Title: "Logo"})
+	require.NoError(t, err)
+	require.Equal(t, "file-2", file.ID)
+}
@@ -31,6 +31,10 @@ func (f filterOperator) MarshalJSON() ([]byte, error) {
 }
 
 func (f filterOperator) String() string {
+	switch f.op {
+	case "_null", "_nnull", "_empty", "_nempty":
+		return fmt.Sprintf("%s %s", f.field, f.op)
+	}
 	return fmt.Sprintf("%s %s %v", f.field, f.op, f.value)
 }
 
@@ -78,13 +82,130 @@ func StartsWith(field string, value string) Filter {
 	return filterOperator{field: field, op: "_starts_with", value: value}
 }
 
+func IStartsWith(field string, value string) Filter {
+	return filterOperator{field: field, op: "_istarts_with", value: value}
+}
+
+func NotStartsWith(field string, value string) Filter {
+	return filterOperator{field: field, op: "_nstarts_with", value: value}
+}
+
+func NotIStartsWith(field string, value string) Filter {
+	return filterOperator{field: field, op: "_nistarts_with", value: value}
+}
+
+func EndsWith(field string, value string) Filter {
+	return filterOperator{field: field, op: "_ends_with", value: value}
+}
+
+func IEndsWith(field string, value string) Filter {
+	return filterOperator{field: field, op: "_iends_with", value: value}
+}
+
+func NotEndsWith(field string, value string) Filter {
+	return filterOperator{field: field, op: "_nends_with", value: value}
+}
+
+func NotIEndsWith(field string, value string) Filter {
+	return filterOperator{field: field, op: "_niends_with", value: value}
+}
+
+func Contains(field string, value string) Filter {
+	return filterOperator{field: field, op: "_contains", value: value}
+}
+
+func IContains(field string, value string) Filter {
+	return filterOperator{field: field, op: "_icontains", value: value}
+}
+
+func NotContains(field string, value string) Filter {
+	return filterOperator{field: field, op: "_ncontains", value: value}
+}
+
+func NotIContains(field string, value string) Filter {
+	return filterOperator{field: field, op: "_nicontains", value: value}
+}
+
+func NotIn(field string, values ...any) Filter {
+	return filterOperator{field: field, op: "_nin", value: values}
+}
+
+func Null(field string) Filter {
+	return filterOperator{field: field, op: "_null", value: true}
+}
+
+func NotNull(field string) Filter {
+	return filterOperator{field: field, op: "_nnull", value: true}
+}
+
+func NotBetween(field string, from, to any) Filter {
+	return filterOperator{field: field, op: "_nbetween", value: []any{from, to}}
+}
+
+// Regex filters values that match the regular expression. It is only supported in some databases.
+func Regex(field string, pattern string) Filter {
+	return filterOperator{field: field, op: "_regex", value: pattern}
+}
+
+// Intersects filters geometries that intersect with the GeoJSON value.
+func Intersects(field string, geojson any) Filter {
+	return filterOperator{field: field, op: "_intersects", value: geojson}
+}
+
+// NotIntersects filters geometries that do not intersect with the GeoJSON value.
+func NotIntersects(field string, geojson any) Filter {
+	return filterOperator{field: field, op: "_nintersects", value: geojson}
+}
+
+// IntersectsBBox filters geometries that intersect with the bounding box of the GeoJSON value.
+func IntersectsBBox(field string, geojson any) Filter {
+	return filterOperator{field: field, op: "_intersects_bbox", value: geojson}
+}
+
+// NotIntersectsBBox filters geometries that do not intersect with the bounding box of the GeoJSON value.
+func NotIntersectsBBox(field string, geojson any) Filter {
+	return filterOperator{field: field, op: "_nintersects_bbox", value: geojson}
+}
+
+type filterQuantifier struct {
+	field  string
+	op     string
+	filter Filter
+}
+
+func (f filterQuantifier) content() any {
+	return map[string]any{
+		f.field: map[string]any{
+			f.op: f.filter.content(),
+		},
+	}
+}
+
+func (f filterQuantifier) String() string {
+	return fmt.Sprintf("%s %s (%s)", f.field, f.op, f.filter.String())
+}
+
+func (f filterQuantifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.content())
+}
+
+// Some filters items where at least one of the related items of a one-to-many field matches the filter.
+func Some(field string, filter Filter) Filter {
+	return filterQuantifier{field: field, op: "_some", filter: filter}
+}
+
+// None filters items where none of the related items of a one-to-many field matches the filter.
+func None(field string, filter Filter) Filter {
+	return filterQuantifier{field: field, op: "_none", filter: filter}
+}
+
 type filterLogical struct {
 	op     string
 	values []Filter
 }
 
 func (f filterLogical) content() any {
-	var values []any
+	values := make([]any, 0, len(f.values))
 	for _, v := range f.values {
 		values = append(values, v.content())
 	}
@@ -169,3 +290,102 @@ func FilterJSON(filter Filter) (string, error) {
 	}
 	return buf.String(), nil
 }
+
+// DynamicVariable is a value of a filter replaced by the server with the context of the request when applying it.
+type DynamicVariable string
+
+const (
+	VarCurrentUser     DynamicVariable = "$CURRENT_USER"
+	VarCurrentRole     DynamicVariable = "$CURRENT_ROLE"
+	VarCurrentRoles    DynamicVariable = "$CURRENT_ROLES"
+	VarCurrentPolicies DynamicVariable = "$CURRENT_POLICIES"
+	VarNow             DynamicVariable = "$NOW"
+)
+
+// VarNowAdjusted is the current time adjusted by the interval, for example "-1 year" or "+2 hours".
+func VarNowAdjusted(adjustment string) DynamicVariable {
+	return DynamicVariable(fmt.Sprintf("%s(%s)", VarNow, adjustment))
+}
+
+// Field accesses a nested field of the user or role variables, for example VarCurrentUser.Field("role.name").
+func (v DynamicVariable) Field(path string) DynamicVariable {
+	return DynamicVariable(fmt.Sprintf("%s.%s", v, path))
+}
+
+// FuncYear extracts the year of a date field to use it as the field of a filter.
+func FuncYear(field string) string {
+	return fieldFunction("year", field)
+}
+
+// FuncMonth extracts the month of a date field to use it as the field of a filter.
+func FuncMonth(field string) string {
+	return fieldFunction("month", field)
+}
+
+// FuncWeek extracts the week of the year of a date field to use it as the field of a filter.
+func FuncWeek(field string) string {
+	return fieldFunction("week", field)
+}
+
+// FuncDay extracts the day of the month of a date field to use it as the field of a filter.
+func FuncDay(field string) string {
+	return fieldFunction("day", field)
+}
+
+// FuncWeekday extracts the day of the week of a date field to use it as the field of a filter.
+func FuncWeekday(field string) string {
+	return fieldFunction("weekday", field)
+}
+
+// FuncHour extracts the hour of a time field to use it as the field of a filter.
+func FuncHour(field string) string {
+	return fieldFunction("hour", field)
+}
+
+// FuncMinute extracts the minute of a time field to use it as the field of a filter.
+func FuncMinute(field string) string {
+	return fieldFunction("minute", field)
+}
+
+// FuncSecond extracts the second of a time field to use it as the field of a filter.
+func FuncSecond(field string) string {
+	return fieldFunction("second", field)
+}
+
+// FuncCount counts the related items of a relational field, or the elements of a JSON array, to use it as the field
+// of a filter.
+func FuncCount(field string) string {
+	return fieldFunction("count", field)
+}
+
+func fieldFunction(fn, field string) string {
+	return fmt.Sprintf("%s(%s)", fn, field)
+}
+
+func isNoopFilter(filter Filter) bool {
+	return isEmptyFilter(filter)
+}
+
+// isEmptyFilter reports whether the filter does not have any condition: it is missing, Noop or a logical filter whose
+// children are all empty too.
+func isEmptyFilter(filter Filter) bool {
+	switch filter := filter.(type) {
+	case nil, filterEmpty:
+		return true
+	case filterLogical:
+		for _, v := range filter.values {
+			if !isEmptyFilter(v) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func andFilters(base Filter, filter Filter) Filter {
+	if isNoopFilter(base) {
+		return filter
+	}
+	return And(base, filter)
+}
@@ -0,0 +1,174 @@
+package directus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var knownFilterOperators = map[string]bool{
+	"_eq":               true,
+	"_neq":              true,
+	"_lt":               true,
+	"_lte":              true,
+	"_gt":               true,
+	"_gte":              true,
+	"_in":               true,
+	"_nin":              true,
+	"_null":             true,
+	"_nnull":            true,
+	"_contains":         true,
+	"_icontains":        true,
+	"_ncontains":        true,
+	"_nicontains":       true,
+	"_starts_with":      true,
+	"_istarts_with":     true,
+	"_nstarts_with":     true,
+	"_nistarts_with":    true,
+	"_ends_with":        true,
+	"_iends_with":       true,
+	"_nends_with":       true,
+	"_niends_with":      true,
+	"_between":          true,
+	"_nbetween":         true,
+	"_empty":            true,
+	"_nempty":           true,
+	"_intersects":       true,
+	"_nintersects":      true,
+	"_intersects_bbox":  true,
+	"_nintersects_bbox": true,
+	"_regex":            true,
+}
+
+// ParseFilter reads a filter in the JSON format used by Directus. It fails if the filter contains unknown operators.
+// Several conditions in the same object are read as an And filter sorted by field name.
+func ParseFilter(data []byte) (Filter, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("directus: cannot decode filter: %w", err)
+	}
+	return ParseFilterValue(value)
+}
+
+// ParseFilterValue reads a filter that has already been decoded from JSON, like the ones stored in the Unknown
+// fields of permissions and presets.
+func ParseFilterValue(value any) (Filter, error) {
+	if value == nil {
+		return Noop(), nil
+	}
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("directus: filter should be an object, got %T", value)
+	}
+	return parseFilterObject(obj)
+}
+
+func parseFilterObject(obj map[string]any) (Filter, error) {
+	if len(obj) == 0 {
+		return Noop(), nil
+	}
+
+	var filters []Filter
+	for _, key := range sortedKeys(obj) {
+		filter, err := parseFilterKey(key, obj[key])
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return And(filters...), nil
+}
+
+func parseFilterKey(key string, value any) (Filter, error) {
+	if key == "_and" || key == "_or" {
+		list, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("directus: filter operator %q should contain a list, got %T", key, value)
+		}
+		logical := filterLogical{op: key, values: make([]Filter, 0, len(list))}
+		for _, item := range list {
+			filter, err := ParseFilterValue(item)
+			if err != nil {
+				return nil, err
+			}
+			logical.values = append(logical.values, filter)
+		}
+		return logical, nil
+	}
+	if strings.HasPrefix(key, "_") {
+		return nil, fmt.Errorf("directus: unknown filter operator %q", key)
+	}
+
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("directus: filter of field %q should be an object, got %T", key, value)
+	}
+	if len(obj) == 0 {
+		return nil, fmt.Errorf("directus: filter of field %q is empty", key)
+	}
+
+	var filters []Filter
+	nested := make(map[string]any)
+	for _, op := range sortedKeys(obj) {
+		switch {
+		case op == "_and" || op == "_or" || !strings.HasPrefix(op, "_"):
+			nested[op] = obj[op]
+
+		case op == "_some" || op == "_none":
+			inner, err := ParseFilterValue(obj[op])
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, filterQuantifier{field: key, op: op, filter: inner})
+
+		case knownFilterOperators[op]:
+			filters = append(filters, filterOperator{field: key, op: op, value: parseFilterOperand(obj[op])})
+
+		default:
+			return nil, fmt.Errorf("directus: unknown filter operator %q in field %q", op, key)
+		}
+	}
+	if len(nested) > 0 {
+		inner, err := parseFilterObject(nested)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, Related(key, inner))
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return And(filters...), nil
+}
+
+func parseFilterOperand(value any) any {
+	switch value := value.(type) {
+	case string:
+		if strings.HasPrefix(value, "$") {
+			return DynamicVariable(value)
+		}
+	case []any:
+		values := make([]any, len(value))
+		for i, v := range value {
+			values[i] = parseFilterOperand(v)
+		}
+		return values
+	}
+	return value
+}
+
+func sortedKeys(obj map[string]any) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,82 @@
+package directus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilterRoundTrip(t *testing.T) {
+	tests := []string{
+		`{"status": {"_eq": "published"}}`,
+		`{"_and": [{"domain": {"_eq": "foo"}}, {"_or": [{"age": {"_gt": 18}}, {"age": {"_null": true}}]}]}`,
+		`{"author": {"name": {"_icontains": "foo"}}}`,
+		`{"author": {"_or": [{"name": {"_eq": "foo"}}, {"name": {"_eq": "bar"}}]}}`,
+		`{"translations": {"_some": {"languages_code": {"_in": ["en-GB", "es-ES"]}}}}`,
+		`{"user_created": {"_eq": "$CURRENT_USER"}}`,
+		`{"date_created": {"_between": ["$NOW(-1 year)", "$NOW"]}}`,
+		`{"year(date_created)": {"_eq": 2024}}`,
+		`{"price": {"_gte": 10.5}}`,
+		`{}`,
+		`{"_and": []}`,
+		`{"_or": [{"_and": []}]}`,
+	}
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			filter, err := ParseFilter([]byte(test))
+			require.NoError(t, err)
+
+			got, err := FilterJSON(filter)
+			require.NoError(t, err)
+			require.JSONEq(t, test, got)
+		})
+	}
+}
+
+func TestParseFilterEquivalentToBuilders(t *testing.T) {
+	filter, err := ParseFilter([]byte(`{"_and": [{"owner": {"_eq": "$CURRENT_USER"}}, {"tags": {"_nin": ["a", "b"]}}]}`))
+	require.NoError(t, err)
+	require.Equal(t, And(Eq("owner", VarCurrentUser), NotIn("tags", "a", "b")), filter)
+	require.Equal(t, "owner _eq $CURRENT_USER && tags _nin [a b]", filter.String())
+}
+
+func TestParseFilterImplicitAnd(t *testing.T) {
+	filter, err := ParseFilter([]byte(`{"status": {"_eq": "published"}, "age": {"_gt": 18, "_lt": 65}}`))
+	require.NoError(t, err)
+
+	got, err := FilterJSON(filter)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"_and": [
+			{"_and": [{"age": {"_gt": 18}}, {"age": {"_lt": 65}}]},
+			{"status": {"_eq": "published"}}
+		]
+	}`, got)
+}
+
+func TestParseFilterUnknownOperator(t *testing.T) {
+	_, err := ParseFilter([]byte(`{"status": {"_foo": "published"}}`))
+	require.EqualError(t, err, `directus: unknown filter operator "_foo" in field "status"`)
+
+	_, err = ParseFilter([]byte(`{"_not": [{"status": {"_eq": "published"}}]}`))
+	require.EqualError(t, err, `directus: unknown filter operator "_not"`)
+
+	_, err = ParseFilter([]byte(`{"status": "published"}`))
+	require.EqualError(t, err, `directus: filter of field "status" should be an object, got string`)
+}
+
+func TestParseFilterValuePermission(t *testing.T) {
+	var permission Permission
+	require.NoError(t, permission.UnmarshalJSON([]byte(`{
+		"id": 1,
+		"collection": "regimes",
+		"action": "read",
+		"policy": null,
+		"fields": ["*"],
+		"permissions": {"user_created": {"_eq": "$CURRENT_USER"}}
+	}`)))
+
+	filter, err := ParseFilterValue(permission.Unknown["permissions"])
+	require.NoError(t, err)
+	require.Equal(t, Eq("user_created", VarCurrentUser), filter)
+}
@@ -17,3 +17,80 @@ func TestFiltersMultipleAnd(t *testing.T) {
 		]
 	}`)
 }
+
+func TestFiltersOperators(t *testing.T) {
+	point := map[string]any{"type": "Point", "coordinates": []float64{1, 2}}
+	tests := []struct {
+		filter Filter
+		json   string
+		str    string
+	}{
+		{Contains("name", "foo"), `{"name": {"_contains": "foo"}}`, "name _contains foo"},
+		{IContains("name", "foo"), `{"name": {"_icontains": "foo"}}`, "name _icontains foo"},
+		{NotContains("name", "foo"), `{"name": {"_ncontains": "foo"}}`, "name _ncontains foo"},
+		{NotIContains("name", "foo"), `{"name": {"_nicontains": "foo"}}`, "name _nicontains foo"},
+		{NotIn("id", 1, 2), `{"id": {"_nin": [1, 2]}}`, "id _nin [1 2]"},
+		{Null("parent"), `{"parent": {"_null": true}}`, "parent _null"},
+		{NotNull("parent"), `{"parent": {"_nnull": true}}`, "parent _nnull"},
+		{Empty("tags"), `{"tags": {"_empty": null}}`, "tags _empty"},
+		{NotBetween("age", 18, 65), `{"age": {"_nbetween": [18, 65]}}`, "age _nbetween [18 65]"},
+		{EndsWith("email", ".com"), `{"email": {"_ends_with": ".com"}}`, "email _ends_with .com"},
+		{IEndsWith("email", ".com"), `{"email": {"_iends_with": ".com"}}`, "email _iends_with .com"},
+		{NotEndsWith("email", ".com"), `{"email": {"_nends_with": ".com"}}`, "email _nends_with .com"},
+		{NotIEndsWith("email", ".com"), `{"email": {"_niends_with": ".com"}}`, "email _niends_with .com"},
+		{IStartsWith("code", "ab"), `{"code": {"_istarts_with": "ab"}}`, "code _istarts_with ab"},
+		{NotStartsWith("code", "ab"), `{"code": {"_nstarts_with": "ab"}}`, "code _nstarts_with ab"},
+		{NotIStartsWith("code", "ab"), `{"code": {"_nistarts_with": "ab"}}`, "code _nistarts_with ab"},
+		{Regex("code", "^a.+$"), `{"code": {"_regex": "^a.+$"}}`, "code _regex ^a.+$"},
+		{Intersects("area", point), `{"area": {"_intersects": {"type": "Point", "coordinates": [1, 2]}}}`, "area _intersects map[coordinates:[1 2] type:Point]"},
+		{NotIntersects("area", point), `{"area": {"_nintersects": {"type": "Point", "coordinates": [1, 2]}}}`, "area _nintersects map[coordinates:[1 2] type:Point]"},
+		{IntersectsBBox("area", point), `{"area": {"_intersects_bbox": {"type": "Point", "coordinates": [1, 2]}}}`, "area _intersects_bbox map[coordinates:[1 2] type:Point]"},
+		{NotIntersectsBBox("area", point), `{"area": {"_nintersects_bbox": {"type": "Point", "coordinates": [1, 2]}}}`, "area _nintersects_bbox map[coordinates:[1 2] type:Point]"},
+		{Some("translations", Eq("languages_code", "en-GB")), `{"translations": {"_some": {"languages_code": {"_eq": "en-GB"}}}}`, "translations _some (languages_code _eq en-GB)"},
+		{None("translations", Empty("display_name")), `{"translations": {"_none": {"display_name": {"_empty": null}}}}`, "translations _none (display_name _empty)"},
+	}
+	for _, test := range tests {
+		t.Run(test.str, func(t *testing.T) {
+			got, err := FilterJSON(test.filter)
+			require.NoError(t, err)
+			require.JSONEq(t, test.json, got)
+			require.Equal(t, test.str, test.filter.String())
+		})
+	}
+}
+
+func TestFiltersDynamicVariables(t *testing.T) {
+	filter := And(
+		Eq("user_created", VarCurrentUser),
+		Eq("role", VarCurrentUser.Field("role.id")),
+		Between("date_created", VarNowAdjusted("-1 year"), VarNow),
+	)
+	got, err := FilterJSON(filter)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"_and": [
+			{ "user_created": { "_eq": "$CURRENT_USER" } },
+			{ "role": { "_eq": "$CURRENT_USER.role.id" } },
+			{ "date_created": { "_between": ["$NOW(-1 year)", "$NOW"] } }
+		]
+	}`, got)
+	require.Equal(t, "user_created _eq $CURRENT_USER && role _eq $CURRENT_USER.role.id && date_created _between [$NOW(-1 year) $NOW]", filter.String())
+}
+
+func TestFiltersFunctions(t *testing.T) {
+	filter := And(
+		Eq(FuncYear("date_created"), 2024),
+		Gt(FuncCount("translations"), 1),
+		Related("author", Lte(FuncMonth("birthday"), 6)),
+	)
+	got, err := FilterJSON(filter)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"_and": [
+			{ "year(date_created)": { "_eq": 2024 } },
+			{ "count(translations)": { "_gt": 1 } },
+			{ "author": { "month(birthday)": { "_lte": 6 } } }
+		]
+	}`, got)
+	require.Equal(t, "year(date_created) _eq 2024 && count(translations) _gt 1 && author.month(birthday) _lte 6", filter.String())
+}
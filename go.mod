@@ -1,6 +1,6 @@
 module github.com/altipla-consulting/directus-go/v2
 
-go 1.21.4
+go 1.23
 
 require (
 	github.com/perimeterx/marshmallow v1.1.5
@@ -9,6 +9,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"sync"
 )
 
 // ItemsClient access the items API in a type-safe way.
@@ -16,11 +18,20 @@ type ItemsClient[T any] struct {
 	c          *Client
 	collection string
 	opts       []ReadOption
+
+	pkMu sync.Mutex
+	pk   string
 }
 
 type readOptionApply struct {
 	req  *http.Request
 	deep map[string]deepFilter
+
+	// itemType is the type of the items read by the request.
+	itemType reflect.Type
+
+	// err is the first error found by the options, it is returned before sending the request.
+	err error
 }
 
 type deepFilter struct {
@@ -72,6 +83,25 @@ func WithOffset(offset int64) ReadOption {
 	}
 }
 
+// WithSearch filters the items that contain the query in any of their text or numeric fields.
+func WithSearch(query string) ReadOption {
+	return func(apply *readOptionApply) {
+		q := apply.req.URL.Query()
+		q.Set("search", query)
+		apply.req.URL.RawQuery = q.Encode()
+	}
+}
+
+// WithAlias returns the field again under a different name. It is useful to read the same relation multiple times
+// with different deep filters.
+func WithAlias(alias, field string) ReadOption {
+	return func(apply *readOptionApply) {
+		q := apply.req.URL.Query()
+		q.Set(fmt.Sprintf("alias[%s]", alias), field)
+		apply.req.URL.RawQuery = q.Encode()
+	}
+}
+
 // WithDeepSort sorts the deep relations of each returned item by the given fields. Use a minus sign (-) to sort in
 // descending order. It does not order the items themselves. To sort the items, use WithSort.
 func WithDeepSort(field string, sort ...string) ReadOption {
@@ -111,16 +141,24 @@ func NewItemsClient[T any](client *Client, collection string, opts ...ReadOption
 }
 
 func (items *ItemsClient[T]) applyOpts(req *http.Request, opts ...ReadOption) error {
+	return applyReadOptions(req, reflect.TypeFor[T](), items.opts, opts)
+}
+
+// applyReadOptions configures the request with the groups of options in order. The itemType is the type of the items
+// returned by the request.
+func applyReadOptions(req *http.Request, itemType reflect.Type, groups ...[]ReadOption) error {
 	apply := &readOptionApply{
-		req:  req,
-		deep: make(map[string]deepFilter),
+		req:      req,
+		deep:     make(map[string]deepFilter),
+		itemType: itemType,
 	}
-
-	for _, opt := range items.opts {
-		opt(apply)
+	for _, opts := range groups {
+		for _, opt := range opts {
+			opt(apply)
+		}
 	}
-	for _, opt := range opts {
-		opt(apply)
+	if apply.err != nil {
+		return apply.err
 	}
 
 	q := apply.req.URL.Query()
@@ -231,8 +269,8 @@ func (items *ItemsClient[T]) Get(ctx context.Context, id string, opts ...ReadOpt
 		Data *T `json:"data"`
 	}{}
 	if err := items.c.sendRequest(req, &reply); err != nil {
-		var e *unexpectedStatusError
-		if errors.As(err, &e) && e.status == http.StatusForbidden {
+		var e *APIError
+		if errors.As(err, &e) && e.Status == http.StatusForbidden {
 			return nil, fmt.Errorf("%w: %v", ErrItemNotFound, id)
 		}
 		return nil, err
@@ -270,6 +308,187 @@ func (items *ItemsClient[T]) Delete(ctx context.Context, id string) error {
 	return items.itemsdo(ctx, http.MethodDelete, items.c.urlf("/items/%s/%s", items.collection, id), nil, nil)
 }
 
+// Upsert creates the item if there is no other item with the same value in the field, or updates the existing one
+// otherwise. If field is empty it uses the primary key of the collection. The field should be unique in the
+// collection. If another process creates the item at the same time the write is retried as an update. Items without
+// a primary key are always created when upserting by the primary key.
+func (items *ItemsClient[T]) Upsert(ctx context.Context, field string, item *T) (*T, error) {
+	reply, _, err := items.upsert(ctx, field, item)
+	return reply, err
+}
+
+// upsert writes the item like Upsert and reports whether it was created or updated.
+func (items *ItemsClient[T]) upsert(ctx context.Context, field string, item *T) (*T, bool, error) {
+	byPrimaryKey := field == ""
+	if byPrimaryKey {
+		pk, err := items.primaryKey(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		field = pk
+	}
+	value, err := itemField(item, field)
+	if err != nil {
+		// Items without primary key are always new, the server will generate it.
+		if byPrimaryKey {
+			reply, err := items.Create(ctx, item)
+			return reply, err == nil, err
+		}
+		return nil, false, err
+	}
+
+	keys, err := items.lookupKeys(ctx, field, []any{value})
+	if err != nil {
+		return nil, false, err
+	}
+	if id, ok := keys[fmt.Sprint(value)]; ok {
+		reply, err := items.Update(ctx, id, item)
+		return reply, false, err
+	}
+
+	reply, err := items.Create(ctx, item)
+	if err == nil {
+		return reply, true, nil
+	}
+	if !errors.Is(err, ErrorCodeRecordNotUnique) {
+		return nil, false, err
+	}
+
+	// Another process created the item after the lookup. Update it instead.
+	keys, lookupErr := items.lookupKeys(ctx, field, []any{value})
+	if lookupErr != nil {
+		return nil, false, lookupErr
+	}
+	id, ok := keys[fmt.Sprint(value)]
+	if !ok {
+		// The conflict was caused by a different unique field of the item.
+		return nil, false, err
+	}
+	reply, err = items.Update(ctx, id, item)
+	return reply, false, err
+}
+
+// primaryKey returns the name of the primary key field of the collection, reading it from the server the first time.
+func (items *ItemsClient[T]) primaryKey(ctx context.Context) (string, error) {
+	items.pkMu.Lock()
+	defer items.pkMu.Unlock()
+
+	if items.pk != "" {
+		return items.pk, nil
+	}
+	fields, err := items.c.Fields.ListCollection(ctx, items.collection)
+	if err != nil {
+		return "", err
+	}
+	for _, field := range fields {
+		if field.Schema != nil && field.Schema.IsPrimaryKey {
+			items.pk = field.Field
+			return items.pk, nil
+		}
+	}
+	return "", fmt.Errorf("directus: collection %q has no primary key", items.collection)
+}
+
+// lookupKeys searches the items whose field has one of the values and returns their primary keys indexed by the
+// value of the field formatted as a string.
+func (items *ItemsClient[T]) lookupKeys(ctx context.Context, field string, values []any) (map[string]string, error) {
+	pk, err := items.primaryKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(items.c.urlf("/items/%s", items.collection))
+	if err != nil {
+		return nil, err
+	}
+	f, err := FilterJSON(In(field, values...))
+	if err != nil {
+		return nil, err
+	}
+	qs := u.Query()
+	qs.Set("filter", f)
+	qs.Add("fields[]", pk)
+	qs.Add("fields[]", field)
+	qs.Set("limit", "-1")
+	u.RawQuery = qs.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	reply := struct {
+		Data []map[string]json.RawMessage `json:"data"`
+	}{}
+	if err := items.c.sendRequest(req, &reply); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]string)
+	for _, item := range reply.Data {
+		var key, value any
+		if err := decodeNumber(item[pk], &key); err != nil {
+			return nil, fmt.Errorf("directus: cannot decode primary key: %v", err)
+		}
+		if err := decodeNumber(item[field], &value); err != nil {
+			return nil, fmt.Errorf("directus: cannot decode field %q: %v", field, err)
+		}
+		keys[fmt.Sprint(value)] = fmt.Sprint(key)
+	}
+	return keys, nil
+}
+
+func decodeNumber(data []byte, dest any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(dest)
+}
+
+// KeyedItemsClient access the items API like ItemsClient but checking the type of the primary keys of the collection.
+// Numeric and UUID keys can be used directly without converting them to strings.
+type KeyedItemsClient[T any, PK string | int64] struct {
+	*ItemsClient[T]
+}
+
+// NewKeyedItemsClient creates a new client to access & write items with primary keys of type PK.
+func NewKeyedItemsClient[T any, PK string | int64](client *Client, collection string, opts ...ReadOption) *KeyedItemsClient[T, PK] {
+	return &KeyedItemsClient[T, PK]{
+		ItemsClient: NewItemsClient[T](client, collection, opts...),
+	}
+}
+
+// Get a single item by its primary key. If it cannot be found, it returns ErrItemNotFound.
+func (items *KeyedItemsClient[T, PK]) Get(ctx context.Context, id PK, opts ...ReadOption) (*T, error) {
+	var zero PK
+	if id == zero {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, id)
+	}
+	return items.ItemsClient.Get(ctx, fmt.Sprint(id), opts...)
+}
+
+// Update an item in the collection by its primary key.
+func (items *KeyedItemsClient[T, PK]) Update(ctx context.Context, id PK, item *T) (*T, error) {
+	return items.ItemsClient.Update(ctx, fmt.Sprint(id), item)
+}
+
+// Delete an item from the collection by its primary key.
+func (items *KeyedItemsClient[T, PK]) Delete(ctx context.Context, id PK) error {
+	return items.ItemsClient.Delete(ctx, fmt.Sprint(id))
+}
+
+// UpdateMany applies the same changes to multiple items of the collection by their primary keys like
+// ItemsClient.UpdateMany.
+func (items *KeyedItemsClient[T, PK]) UpdateMany(ctx context.Context, ids []PK, item *T, opts ...BatchOption) ([]*T, error) {
+	return updateMany(ctx, items.ItemsClient, ids, item, opts)
+}
+
+// DeleteMany deletes multiple items from the collection by their primary keys like ItemsClient.DeleteMany.
+func (items *KeyedItemsClient[T, PK]) DeleteMany(ctx context.Context, ids []PK, opts ...BatchOption) error {
+	return deleteMany(ctx, items.ItemsClient, ids, opts)
+}
+
 type SingletonClient[T any] struct {
 	items *ItemsClient[T]
 }
@@ -299,3 +518,20 @@ func (s *SingletonClient[T]) Update(ctx context.Context, item *T) (*T, error) {
 	}
 	return reply.Data, nil
 }
+
+// itemField extracts the JSON value of a top level field of an item.
+func itemField(item any, field string) (any, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot encode item: %v", err)
+	}
+	var values map[string]any
+	if err := decodeNumber(data, &values); err != nil {
+		return nil, fmt.Errorf("directus: cannot decode item: %v", err)
+	}
+	value, ok := values[field]
+	if !ok || value == nil {
+		return nil, fmt.Errorf("directus: field %q not present in item", field)
+	}
+	return value, nil
+}
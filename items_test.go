@@ -3,9 +3,11 @@ package directus
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -220,3 +222,94 @@ func TestItemsMultipleDeepFilter(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, `/items/foo?deep={"another_field":{"_filter":{"display_name":{"_eq":"foo"}}},"translations":{"_filter":{"languages_code":{"_eq":"en-GB"}}}}&limit=-1`, got)
 }
+
+func TestItemsUpsertCreate(t *testing.T) {
+	rs, s := newRegimesServer(t)
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	regime, err := items.Upsert(context.Background(), "code", &Regime{Code: "foo", Status: "published"})
+	require.NoError(t, err)
+	require.Equal(t, "id-foo", regime.ID)
+	require.Equal(t, 1, rs.creates)
+	require.Zero(t, rs.updates)
+}
+
+func TestItemsUpsertUpdate(t *testing.T) {
+	rs, s := newRegimesServer(t, &Regime{ID: "existing", Code: "foo", Status: "draft"})
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	regime, err := items.Upsert(context.Background(), "code", &Regime{Code: "foo", Status: "published"})
+	require.NoError(t, err)
+	require.Equal(t, "existing", regime.ID)
+	require.Equal(t, "published", regime.Status)
+	require.Zero(t, rs.creates)
+	require.Equal(t, 1, rs.updates)
+}
+
+func TestItemsUpsertRace(t *testing.T) {
+	rs, s := newRegimesServer(t, &Regime{ID: "existing", Code: "foo", Status: "draft"})
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	rs.staleLookups = 1
+	regime, err := items.Upsert(context.Background(), "code", &Regime{Code: "foo", Status: "published"})
+	require.NoError(t, err)
+	require.Equal(t, "existing", regime.ID)
+	require.Equal(t, "published", rs.byCode["foo"].Status)
+	require.Equal(t, 1, rs.updates)
+}
+
+func TestItemsUpsertPrimaryKey(t *testing.T) {
+	rs, s := newRegimesServer(t, &Regime{ID: "existing", Code: "foo", Status: "draft"})
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	_, err := items.Upsert(context.Background(), "", &Regime{ID: "existing", Code: "foo", Status: "published"})
+	require.NoError(t, err)
+	require.Equal(t, 1, rs.updates)
+
+	regime, err := items.Upsert(context.Background(), "", &Regime{Code: "bar", Status: "published"})
+	require.NoError(t, err)
+	require.Equal(t, "id-bar", regime.ID)
+	require.Equal(t, 1, rs.creates)
+}
+
+func TestKeyedItemsClient(t *testing.T) {
+	var requests []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(body)))
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPatch:
+			fmt.Fprint(w, `{"data": [{"id": 3, "name": "Single"}]}`)
+		default:
+			fmt.Fprint(w, `{"data": {"id": 3, "name": "Single"}}`)
+		}
+	}))
+	defer s.Close()
+	items := NewKeyedItemsClient[Room, int64](NewClient(s.URL, "local-token"), "rooms")
+
+	room, err := items.Get(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), room.ID)
+
+	_, err = items.Get(context.Background(), 0)
+	require.ErrorIs(t, err, ErrItemNotFound)
+
+	_, err = items.UpdateMany(context.Background(), []int64{3, 4}, &Room{Name: "Single"})
+	require.NoError(t, err)
+	require.NoError(t, items.DeleteMany(context.Background(), []int64{3, 4}))
+	require.NoError(t, items.Delete(context.Background(), 3))
+
+	require.Equal(t, []string{
+		"GET /items/rooms/3",
+		`PATCH /items/rooms {"keys":[3,4],"data":{"name":"Single","beds":{}}}`,
+		"DELETE /items/rooms [3,4]",
+		"DELETE /items/rooms/3",
+	}, requests)
+}
@@ -0,0 +1,148 @@
+package directus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+var (
+	manyToAnyMu    sync.RWMutex
+	manyToAnyTypes = make(map[string]reflect.Type)
+)
+
+// RegisterManyToAny associates the items of a collection with a Go type to decode them when they are found inside a
+// many-to-any relation. It should be called during the initialization of the program, before reading any item.
+func RegisterManyToAny[T any](collection string) {
+	manyToAnyMu.Lock()
+	defer manyToAnyMu.Unlock()
+	manyToAnyTypes[collection] = reflect.TypeFor[T]()
+}
+
+func manyToAnyType(collection string) (reflect.Type, bool) {
+	manyToAnyMu.RLock()
+	defer manyToAnyMu.RUnlock()
+	t, ok := manyToAnyTypes[collection]
+	return t, ok
+}
+
+func manyToAnyCollections() []string {
+	manyToAnyMu.RLock()
+	defer manyToAnyMu.RUnlock()
+	collections := make([]string, 0, len(manyToAnyTypes))
+	for collection := range manyToAnyTypes {
+		collections = append(collections, collection)
+	}
+	slices.Sort(collections)
+	return collections
+}
+
+// ManyToAny is a row of the junction collection of a many-to-any relation. Each row points to an item of a
+// different collection.
+//
+// To read the items request them for each collection with paths like "blocks.item:hero.*" and register their types
+// with RegisterManyToAny.
+type ManyToAny struct {
+	Collection string `json:"collection"`
+
+	// Item is a pointer to the registered type of the collection when the item is expanded, the raw JSON if the
+	// collection is not registered, or the primary key of the item if it is not expanded.
+	Item any `json:"item"`
+
+	// Unknown keeps the rest of the fields of the junction row, like its primary key or the sort field.
+	Unknown map[string]any `json:"-"`
+}
+
+// NewManyToAny builds a relation to an item of the collection. The item can be the primary key of an existing item or
+// a pointer to a new item to create it.
+func NewManyToAny(collection string, item any) ManyToAny {
+	return ManyToAny{Collection: collection, Item: item}
+}
+
+// ManyToAnyItem extracts the expanded item of the relation if it has the type T.
+func ManyToAnyItem[T any](m ManyToAny) (*T, bool) {
+	item, ok := m.Item.(*T)
+	return item, ok
+}
+
+func (m ManyToAny) MarshalJSON() ([]byte, error) {
+	values := make(map[string]any)
+	for k, v := range m.Unknown {
+		values[k] = v
+	}
+	values["collection"] = m.Collection
+	if m.Item != nil {
+		values["item"] = m.Item
+	}
+	return json.Marshal(values)
+}
+
+func (m *ManyToAny) UnmarshalJSON(data []byte) error {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*m = ManyToAny{}
+	if raw, ok := values["collection"]; ok {
+		if err := json.Unmarshal(raw, &m.Collection); err != nil {
+			return fmt.Errorf("directus: cannot decode many-to-any collection: %v", err)
+		}
+	}
+	if raw := bytes.TrimSpace(values["item"]); len(raw) > 0 {
+		item, err := decodeManyToAnyItem(m.Collection, raw)
+		if err != nil {
+			return err
+		}
+		m.Item = item
+	}
+
+	delete(values, "collection")
+	delete(values, "item")
+	if len(values) > 0 {
+		m.Unknown = make(map[string]any)
+		for k, raw := range values {
+			var v any
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return err
+			}
+			m.Unknown[k] = v
+		}
+	}
+	return nil
+}
+
+func decodeManyToAnyItem(collection string, raw json.RawMessage) (any, error) {
+	switch raw[0] {
+	case 'n':
+		return nil, nil
+
+	case '{':
+		t, ok := manyToAnyType(collection)
+		if !ok {
+			return raw, nil
+		}
+		item := reflect.New(t)
+		if err := json.Unmarshal(raw, item.Interface()); err != nil {
+			return nil, fmt.Errorf("directus: cannot decode many-to-any item of %q: %v", collection, err)
+		}
+		return item.Interface(), nil
+
+	case '"':
+		var id string
+		if err := json.Unmarshal(raw, &id); err != nil {
+			return nil, err
+		}
+		return id, nil
+
+	default:
+		var id int64
+		if err := json.Unmarshal(raw, &id); err != nil {
+			return nil, fmt.Errorf("directus: cannot decode many-to-any item of %q: %v", collection, err)
+		}
+		return id, nil
+	}
+}
@@ -0,0 +1,116 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type m2aPage struct {
+	Title  string      `json:"title"`
+	Blocks []ManyToAny `json:"blocks"`
+}
+
+type m2aHero struct {
+	Headline string `json:"headline"`
+	Image    string `json:"image"`
+}
+
+type m2aText struct {
+	Body string `json:"body"`
+}
+
+func init() {
+	RegisterManyToAny[m2aHero]("m2a_hero")
+	RegisterManyToAny[m2aText]("m2a_text")
+}
+
+func TestManyToAnyUnmarshal(t *testing.T) {
+	var page m2aPage
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"title": "Home",
+		"blocks": [
+			{"id": 1, "sort": 1, "collection": "m2a_hero", "item": {"headline": "Welcome", "image": "abc"}},
+			{"id": 2, "sort": 2, "collection": "m2a_text", "item": {"body": "Lorem ipsum"}},
+			{"id": 3, "sort": 3, "collection": "m2a_unknown", "item": {"foo": "bar"}},
+			{"id": 4, "sort": 4, "collection": "m2a_text", "item": "f3b9e7a2"},
+			{"id": 5, "sort": 5, "collection": "m2a_hero", "item": 42},
+			{"id": 6, "sort": 6, "collection": "m2a_hero", "item": null}
+		]
+	}`), &page))
+	require.Len(t, page.Blocks, 6)
+
+	hero, ok := ManyToAnyItem[m2aHero](page.Blocks[0])
+	require.True(t, ok)
+	require.Equal(t, "Welcome", hero.Headline)
+	require.Equal(t, "m2a_hero", page.Blocks[0].Collection)
+	require.Equal(t, map[string]any{"id": float64(1), "sort": float64(1)}, page.Blocks[0].Unknown)
+
+	_, ok = ManyToAnyItem[m2aHero](page.Blocks[1])
+	require.False(t, ok)
+	text, ok := ManyToAnyItem[m2aText](page.Blocks[1])
+	require.True(t, ok)
+	require.Equal(t, "Lorem ipsum", text.Body)
+
+	require.JSONEq(t, `{"foo": "bar"}`, string(page.Blocks[2].Item.(json.RawMessage)))
+	require.Equal(t, "f3b9e7a2", page.Blocks[3].Item)
+	require.Equal(t, int64(42), page.Blocks[4].Item)
+	require.Nil(t, page.Blocks[5].Item)
+}
+
+func TestManyToAnyMarshal(t *testing.T) {
+	page := &m2aPage{
+		Title: "Home",
+		Blocks: []ManyToAny{
+			NewManyToAny("m2a_hero", &m2aHero{Headline: "Welcome"}),
+			NewManyToAny("m2a_text", "f3b9e7a2"),
+			{Collection: "m2a_text", Item: int64(42), Unknown: map[string]any{"id": 3, "sort": 2}},
+		},
+	}
+	b, err := json.Marshal(page)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"title": "Home",
+		"blocks": [
+			{"collection": "m2a_hero", "item": {"headline": "Welcome", "image": ""}},
+			{"collection": "m2a_text", "item": "f3b9e7a2"},
+			{"id": 3, "sort": 2, "collection": "m2a_text", "item": 42}
+		]
+	}`, string(b))
+}
+
+func TestManyToAnyPaths(t *testing.T) {
+	for _, path := range []string{"blocks.collection", "blocks.id", "blocks.item", "blocks.item:m2a_hero.headline", "blocks.item:m2a_unknown.foo"} {
+		_, err := Path[m2aPage](path)
+		require.NoError(t, err, path)
+	}
+
+	_, err := Path[m2aPage]("blocks.item:m2a_hero.body")
+	require.EqualError(t, err, `directus: unknown field "body" in path "blocks.item:m2a_hero.body" of directus.m2aPage`)
+}
+
+func TestManyToAnyAutoFields(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields := r.URL.Query()["fields[]"]
+		require.Contains(t, fields, "title")
+		require.Contains(t, fields, "blocks.*")
+		require.Contains(t, fields, "blocks.item:m2a_hero.headline")
+		require.Contains(t, fields, "blocks.item:m2a_hero.image")
+		require.Contains(t, fields, "blocks.item:m2a_text.body")
+		fmt.Fprint(w, `{"data": [{"title": "Home", "blocks": [{"collection": "m2a_hero", "item": {"headline": "Welcome"}}]}]}`)
+	}))
+	defer s.Close()
+	items := NewItemsClient[m2aPage](NewClient(s.URL, "local-token"), "pages", WithAutoFields())
+
+	pages, err := items.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pages, 1)
+	hero, ok := ManyToAnyItem[m2aHero](pages[0].Blocks[0])
+	require.True(t, ok)
+	require.Equal(t, "Welcome", hero.Headline)
+}
@@ -3,6 +3,7 @@ package directus
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 )
 
 type Nullable[T any] struct {
@@ -21,6 +22,10 @@ func (n Nullable[T]) String() string {
 	return "NULL"
 }
 
+func (n Nullable[T]) pathTarget() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
 func (n Nullable[T]) MarshalJSON() ([]byte, error) {
 	if n.Valid {
 		return json.Marshal(n.Value)
@@ -0,0 +1,189 @@
+package directus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+const defaultPageSize = 100
+
+// Page is a single page of items read from a collection.
+type Page[T any] struct {
+	Items []*T
+	Meta  ListMeta
+}
+
+// ListMeta contains the counts returned by Directus when they are requested with WithPageMeta.
+type ListMeta struct {
+	TotalCount  int64 `json:"total_count"`
+	FilterCount int64 `json:"filter_count"`
+}
+
+type pagerOptions struct {
+	size   int64
+	keyset string
+	meta   bool
+	opts   []ReadOption
+}
+
+// PagerOption configures how a collection is walked page by page.
+type PagerOption func(opts *pagerOptions)
+
+// WithPageSize changes the number of items requested in each page. By default it reads 100 items each time, also when
+// the size is zero or negative.
+func WithPageSize(size int64) PagerOption {
+	return func(opts *pagerOptions) {
+		opts.size = size
+	}
+}
+
+// WithKeyset paginates sorting by the field and filtering each page by the last value seen, instead of skipping items
+// with an offset. The field should be unique and sortable, usually the primary key. It replaces any sort applied with
+// WithSort and it must be present in the returned fields.
+func WithKeyset(field string) PagerOption {
+	return func(opts *pagerOptions) {
+		opts.keyset = field
+	}
+}
+
+// WithPageMeta requests the total count of items of the collection and the count of items that match the filter in
+// every page.
+func WithPageMeta() PagerOption {
+	return func(opts *pagerOptions) {
+		opts.meta = true
+	}
+}
+
+// WithPageReadOptions applies the read options to each of the page requests.
+func WithPageReadOptions(opts ...ReadOption) PagerOption {
+	return func(po *pagerOptions) {
+		po.opts = append(po.opts, opts...)
+	}
+}
+
+// Pager reads a collection one page at a time.
+type Pager[T any] struct {
+	items  *ItemsClient[T]
+	filter Filter
+	pagerOptions
+
+	offset int64
+	last   any
+	done   bool
+}
+
+// Pager prepares a paginated read of the items that match the filter. Use Noop() or nil to read the whole collection.
+func (items *ItemsClient[T]) Pager(filter Filter, opts ...PagerOption) *Pager[T] {
+	pager := &Pager[T]{
+		items:  items,
+		filter: filter,
+		pagerOptions: pagerOptions{
+			size: defaultPageSize,
+		},
+	}
+	for _, opt := range opts {
+		opt(&pager.pagerOptions)
+	}
+	if pager.size <= 0 {
+		pager.size = defaultPageSize
+	}
+	return pager
+}
+
+// Next reads the next page of items. It returns ErrNoMorePages when the collection has been completely read.
+func (pager *Pager[T]) Next(ctx context.Context) (*Page[T], error) {
+	if pager.done {
+		return nil, ErrNoMorePages
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pager.items.c.urlf("/items/%s", pager.items.collection), nil)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	if err := pager.items.applyOpts(req, pager.opts...); err != nil {
+		return nil, err
+	}
+
+	filter := pager.filter
+	if pager.keyset != "" && pager.last != nil {
+		filter = andFilters(filter, Gt(pager.keyset, pager.last))
+	}
+
+	q := req.URL.Query()
+	if !isNoopFilter(filter) {
+		f, err := FilterJSON(filter)
+		if err != nil {
+			return nil, err
+		}
+		q.Set("filter", f)
+	}
+	q.Set("limit", fmt.Sprintf("%d", pager.size))
+	if pager.keyset != "" {
+		q.Del("sort")
+		q.Del("sort[]")
+		q.Del("offset")
+		q.Add("sort[]", pager.keyset)
+	} else {
+		q.Set("offset", fmt.Sprintf("%d", pager.offset))
+	}
+	if pager.meta {
+		q.Set("meta", "total_count,filter_count")
+	}
+	req.URL.RawQuery = q.Encode()
+
+	reply := struct {
+		Data []*T     `json:"data"`
+		Meta ListMeta `json:"meta"`
+	}{}
+	if err := pager.items.c.sendRequest(req, &reply); err != nil {
+		return nil, err
+	}
+
+	// The previous page was exactly full and there was nothing else to read.
+	if len(reply.Data) == 0 && pager.offset > 0 {
+		pager.done = true
+		return nil, ErrNoMorePages
+	}
+
+	if int64(len(reply.Data)) < pager.size {
+		pager.done = true
+	} else {
+		pager.offset += int64(len(reply.Data))
+		if pager.keyset != "" {
+			last, err := itemField(reply.Data[len(reply.Data)-1], pager.keyset)
+			if err != nil {
+				return nil, err
+			}
+			pager.last = last
+		}
+	}
+
+	return &Page[T]{
+		Items: reply.Data,
+		Meta:  reply.Meta,
+	}, nil
+}
+
+// All iterates over every item that matches the filter, reading them page by page as needed.
+func (items *ItemsClient[T]) All(ctx context.Context, filter Filter, opts ...PagerOption) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		pager := items.Pager(filter, opts...)
+		for {
+			page, err := pager.Next(ctx)
+			if err != nil {
+				if !errors.Is(err, ErrNoMorePages) {
+					yield(nil, err)
+				}
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
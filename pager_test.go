@@ -0,0 +1,143 @@
+package directus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagerOffset(t *testing.T) {
+	var queries []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q, err := url.QueryUnescape(r.URL.RawQuery)
+		require.NoError(t, err)
+		queries = append(queries, q)
+
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			fmt.Fprint(w, `{"data": [{"id": "1"}, {"id": "2"}], "meta": {"total_count": 5, "filter_count": 3}}`)
+		case "2":
+			fmt.Fprint(w, `{"data": [{"id": "3"}], "meta": {"total_count": 5, "filter_count": 3}}`)
+		}
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	pager := items.Pager(Eq("status", "published"), WithPageSize(2), WithPageMeta(), WithPageReadOptions(WithFields("id")))
+
+	page, err := pager.Next(context.Background())
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	require.EqualValues(t, 5, page.Meta.TotalCount)
+	require.EqualValues(t, 3, page.Meta.FilterCount)
+
+	page, err = pager.Next(context.Background())
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	require.Equal(t, "3", page.Items[0].ID)
+
+	_, err = pager.Next(context.Background())
+	require.ErrorIs(t, err, ErrNoMorePages)
+
+	require.Equal(t, []string{
+		`fields[]=id&filter={"status":{"_eq":"published"}}` + "\n" + `&limit=2&meta=total_count,filter_count&offset=0`,
+		`fields[]=id&filter={"status":{"_eq":"published"}}` + "\n" + `&limit=2&meta=total_count,filter_count&offset=2`,
+	}, queries)
+}
+
+func TestPagerKeyset(t *testing.T) {
+	var queries []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q, err := url.QueryUnescape(r.URL.RawQuery)
+		require.NoError(t, err)
+		queries = append(queries, q)
+
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("filter") == "" {
+			fmt.Fprint(w, `{"data": [{"id": "a"}, {"id": "b"}]}`)
+		} else {
+			fmt.Fprint(w, `{"data": [{"id": "c"}]}`)
+		}
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	var ids []string
+	for item, err := range items.All(context.Background(), Noop(), WithPageSize(2), WithKeyset("id"), WithPageReadOptions(WithSort("-code"))) {
+		require.NoError(t, err)
+		ids = append(ids, item.ID)
+	}
+	require.Equal(t, []string{"a", "b", "c"}, ids)
+
+	require.Equal(t, []string{
+		`limit=2&sort[]=id`,
+		`filter={"id":{"_gt":"b"}}` + "\n" + `&limit=2&sort[]=id`,
+	}, queries)
+}
+
+func TestPagerAllError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	var errs []error
+	for _, err := range items.All(context.Background(), nil) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	require.False(t, errors.Is(errs[0], ErrNoMorePages))
+}
+
+func TestPagerExactPages(t *testing.T) {
+	var limits []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limits = append(limits, r.URL.Query().Get("limit"))
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprint(w, `{"data": [{"id": "1"}, {"id": "2"}]}`)
+		} else {
+			fmt.Fprint(w, `{"data": []}`)
+		}
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	pager := items.Pager(nil, WithPageSize(2))
+	page, err := pager.Next(context.Background())
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+
+	_, err = pager.Next(context.Background())
+	require.ErrorIs(t, err, ErrNoMorePages)
+	_, err = pager.Next(context.Background())
+	require.ErrorIs(t, err, ErrNoMorePages)
+	require.Equal(t, []string{"2", "2"}, limits)
+}
+
+func TestPagerInvalidSize(t *testing.T) {
+	var limits []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limits = append(limits, r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{"data": [{"id": "1"}]}`)
+	}))
+	defer s.Close()
+	items := NewItemsClient[Regime](NewClient(s.URL, "local-token"), "regimes")
+
+	for _, size := range []int64{0, -1} {
+		var ids []string
+		for item, err := range items.All(context.Background(), nil, WithPageSize(size)) {
+			require.NoError(t, err)
+			ids = append(ids, item.ID)
+		}
+		require.Equal(t, []string{"1"}, ids)
+	}
+	require.Equal(t, []string{"100", "100"}, limits)
+}
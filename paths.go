@@ -0,0 +1,318 @@
+package directus
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// FieldPath is the path to a field of the items of type T, checked against the json tags of the struct when created.
+// Nested paths like "translations.display_name" follow relations, nullable values and slices.
+type FieldPath[T any] struct {
+	path string
+	desc bool
+}
+
+// Path validates a field path against the struct T. The wildcard "*" can be used as the last element of the path.
+func Path[T any](path string) (FieldPath[T], error) {
+	if err := validatePath(reflect.TypeFor[T](), path); err != nil {
+		return FieldPath[T]{}, err
+	}
+	return FieldPath[T]{path: path}, nil
+}
+
+// MustPath validates a field path like Path but panics if it is invalid. It is intended to declare paths as package
+// variables that are checked when the program starts.
+func MustPath[T any](path string) FieldPath[T] {
+	p, err := Path[T](path)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the path as expected by Directus. It can be used to build filters with the field.
+func (p FieldPath[T]) String() string {
+	return p.path
+}
+
+// Desc sorts by the field in descending order when used with WithPathSort.
+func (p FieldPath[T]) Desc() FieldPath[T] {
+	p.desc = true
+	return p
+}
+
+// WithPathFields filters the fields of each returned item like WithFields. The paths should be of the same type as the
+// items of the client.
+func WithPathFields[T any](paths ...FieldPath[T]) ReadOption {
+	fields := make([]string, len(paths))
+	for i, p := range paths {
+		fields[i] = p.path
+	}
+	return withPathType[T](WithFields(fields...))
+}
+
+// WithPathSort sorts the returned items by the given fields like WithSort. Use FieldPath.Desc to sort in descending
+// order. The paths should be of the same type as the items of the client.
+func WithPathSort[T any](paths ...FieldPath[T]) ReadOption {
+	sort := make([]string, len(paths))
+	for i, p := range paths {
+		sort[i] = p.path
+		if p.desc {
+			sort[i] = "-" + p.path
+		}
+	}
+	return withPathType[T](WithSort(sort...))
+}
+
+// WithPathDeepFilter adds a filter to the deep relations of the path like WithDeepFilter. The path should be of the
+// same type as the items of the client.
+func WithPathDeepFilter[T any](p FieldPath[T], filter Filter) ReadOption {
+	return withPathType[T](WithDeepFilter(p.path, filter))
+}
+
+// withPathType applies the option only if the paths were validated against the type of the items of the request.
+func withPathType[T any](opt ReadOption) ReadOption {
+	return func(apply *readOptionApply) {
+		if apply.itemType != nil && apply.itemType != reflect.TypeFor[T]() {
+			if apply.err == nil {
+				apply.err = fmt.Errorf("directus: paths of %v cannot be used to read items of %v", reflect.TypeFor[T](), apply.itemType)
+			}
+			return
+		}
+		opt(apply)
+	}
+}
+
+// WithAutoFields requests the fields declared in the struct of the items, following the relations to request their
+// fields too. The response will contain exactly the data that can be decoded in the struct. Relations to structs
+// that keep unknown fields request all of them, and relations that point back to a struct already being expanded only
+// request the primary key. Many-to-any relations request the fields of every collection registered with
+// RegisterManyToAny.
+func WithAutoFields() ReadOption {
+	return func(apply *readOptionApply) {
+		if apply.itemType == nil {
+			return
+		}
+		q := apply.req.URL.Query()
+		for _, field := range autoFields(apply.itemType, "", nil) {
+			q.Add("fields[]", field)
+		}
+		apply.req.URL.RawQuery = q.Encode()
+	}
+}
+
+// relationWrapper is implemented by the relations to expand their fields automatically.
+type relationWrapper interface {
+	relationTarget() reflect.Type
+}
+
+var (
+	relationWrapperType = reflect.TypeFor[relationWrapper]()
+	manyToAnyStructType = reflect.TypeFor[ManyToAny]()
+)
+
+func autoFields(t reflect.Type, prefix string, visiting []reflect.Type) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == rawMessageType {
+		return []string{prefix + "*"}
+	}
+	if _, ok := lookupUnknownField(t); ok {
+		return []string{prefix + "*"}
+	}
+	visiting = append(visiting, t)
+
+	var fields []string
+	for _, field := range jsonFields(t) {
+		name := prefix + field.name
+		if unwrapPathType(field.typ) == manyToAnyStructType {
+			fields = append(fields, name+".*")
+			for _, collection := range manyToAnyCollections() {
+				target, _ := manyToAnyType(collection)
+				if !slices.Contains(visiting, target) {
+					fields = append(fields, autoFields(target, name+".item:"+collection+".", visiting)...)
+				}
+			}
+			continue
+		}
+		target, ok := relationTargetType(field.typ)
+		if !ok {
+			fields = append(fields, name)
+			continue
+		}
+		for target.Kind() == reflect.Pointer {
+			target = target.Elem()
+		}
+		if slices.Contains(visiting, target) {
+			fields = append(fields, name)
+			continue
+		}
+		fields = append(fields, autoFields(target, name+".", visiting)...)
+	}
+	if len(fields) == 0 {
+		return []string{prefix + "*"}
+	}
+	return fields
+}
+
+// relationTargetType returns the type of the related items if the type is a relation, even inside slices, pointers
+// or nullable values.
+func relationTargetType(t reflect.Type) (reflect.Type, bool) {
+	for {
+		switch {
+		case t.Kind() == reflect.Pointer:
+			t = t.Elem()
+		case t.Implements(relationWrapperType):
+			return reflect.Zero(t).Interface().(relationWrapper).relationTarget(), true
+		case t.Implements(pathWrapperType):
+			t = reflect.Zero(t).Interface().(pathWrapper).pathTarget()
+		case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t != rawMessageType:
+			t = t.Elem()
+		default:
+			return nil, false
+		}
+	}
+}
+
+type jsonField struct {
+	name string
+	typ  reflect.Type
+}
+
+// jsonFields lists the fields of the struct with their encoded names, including the promoted fields of embedded
+// structs.
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagName, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && tagName == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				fields = append(fields, jsonFields(embedded)...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if tagName == "" {
+			tagName = field.Name
+		}
+		fields = append(fields, jsonField{name: tagName, typ: field.Type})
+	}
+	return fields
+}
+
+// pathWrapper is implemented by the types that wrap the value of a field, like relations or nullable values, to
+// validate the paths that traverse them.
+type pathWrapper interface {
+	pathTarget() reflect.Type
+}
+
+var (
+	pathWrapperType = reflect.TypeFor[pathWrapper]()
+	rawMessageType  = reflect.TypeFor[json.RawMessage]()
+)
+
+func validatePath(t reflect.Type, path string) error {
+	if path == "" {
+		return fmt.Errorf("directus: empty field path")
+	}
+	root := t
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		if part == "" {
+			return fmt.Errorf("directus: empty field in path %q", path)
+		}
+		if part == "*" {
+			if i != len(parts)-1 {
+				return fmt.Errorf("directus: wildcard should be the last field of path %q", path)
+			}
+			return nil
+		}
+
+		t = unwrapPathType(t)
+		if t == rawMessageType {
+			return nil
+		}
+		switch t.Kind() {
+		case reflect.Map, reflect.Interface:
+			// The content is not known in advance.
+			return nil
+
+		case reflect.Struct:
+			// Many-to-any items are selected for a single collection with "item:collection".
+			name, collection, scoped := strings.Cut(part, ":")
+			field, ok := lookupJSONField(t, name)
+			if !ok {
+				// Types that keep the unknown fields accept any other name.
+				if _, ok := lookupUnknownField(t); ok {
+					return nil
+				}
+				return fmt.Errorf("directus: unknown field %q in path %q of %s", name, path, root)
+			}
+			t = field.typ
+			if scoped {
+				if t, ok = manyToAnyType(collection); !ok {
+					return nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("directus: cannot read field %q in path %q of %s from %s", part, path, root, t)
+		}
+	}
+	return nil
+}
+
+// unwrapPathType removes the pointers, slices, relations and nullable wrappers of a type.
+func unwrapPathType(t reflect.Type) reflect.Type {
+	for {
+		switch {
+		// Pointers to the wrappers also implement the interface, remove them first to avoid calling it on nil.
+		case t.Kind() == reflect.Pointer:
+			t = t.Elem()
+		case t.Implements(pathWrapperType):
+			t = reflect.Zero(t).Interface().(pathWrapper).pathTarget()
+		case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t != rawMessageType:
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+}
+
+// lookupJSONField searches the field of the struct that is encoded with the name, including the promoted fields of
+// embedded structs.
+func lookupJSONField(t reflect.Type, name string) (jsonField, bool) {
+	for _, field := range jsonFields(t) {
+		if field.name == name {
+			return field, true
+		}
+	}
+	return jsonField{}, false
+}
+
+// lookupUnknownField searches the Unknown map that keeps the fields not declared in the struct.
+func lookupUnknownField(t reflect.Type) (reflect.StructField, bool) {
+	field, ok := t.FieldByName("Unknown")
+	if !ok || field.Type.Kind() != reflect.Map || field.Tag.Get("json") != "-" {
+		return reflect.StructField{}, false
+	}
+	return field, true
+}
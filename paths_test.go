@@ -0,0 +1,212 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type pathsHotel struct {
+	ID      string                  `json:"id,omitempty"`
+	Name    string                  `json:"name"`
+	Created time.Time               `json:"date_created"`
+	Extra   json.RawMessage         `json:"extra"`
+	Meta    map[string]any          `json:"meta"`
+	Owner   Relation[User]          `json:"owner"`
+	Preset  Relation[Preset]        `json:"preset"`
+	Regimes []Relation[pathsRegime] `json:"regimes"`
+	Ignored string                  `json:"-"`
+	private string
+}
+
+type pathsRegime struct {
+	pathsAudit
+
+	Code         string                          `json:"code"`
+	Price        Nullable[float64]               `json:"price"`
+	Hotel        Relation[pathsHotel]            `json:"hotel"`
+	Translations []Relation[RegimeTranslation]   `json:"translations"`
+	Parent       *pathsRegime                    `json:"parent"`
+	Previous     Nullable[Relation[pathsRegime]] `json:"previous"`
+}
+
+type pathsRoom struct {
+	ID           int64                           `json:"id"`
+	Parent       *Relation[pathsRoom]            `json:"parent"`
+	Price        *Nullable[float64]              `json:"price"`
+	Translations *Translations[RegimeLocalizedT] `json:"translations"`
+	Beds         *RelationList[Bed, int64]       `json:"beds"`
+}
+
+type pathsAudit struct {
+	UserCreated string `json:"user_created"`
+}
+
+func TestPathValid(t *testing.T) {
+	valid := []string{
+		"*",
+		"id",
+		"name",
+		"extra.any.subfield",
+		"meta.key",
+		"owner.email",
+		"regimes.*",
+		"regimes.code",
+		"regimes.user_created",
+		"regimes.translations.display_name",
+		"regimes.hotel.regimes.parent.previous.code",
+		"preset.unknown_custom_field",
+	}
+	for _, path := range valid {
+		p, err := Path[pathsHotel](path)
+		require.NoError(t, err, path)
+		require.Equal(t, path, p.String())
+	}
+}
+
+func TestPathInvalid(t *testing.T) {
+	invalid := map[string]string{
+		"":                           `directus: empty field path`,
+		"nme":                        `directus: unknown field "nme" in path "nme" of directus.pathsHotel`,
+		"Ignored":                    `directus: unknown field "Ignored" in path "Ignored" of directus.pathsHotel`,
+		"private":                    `directus: unknown field "private" in path "private" of directus.pathsHotel`,
+		"regimes..code":              `directus: empty field in path "regimes..code"`,
+		"regimes.*.code":             `directus: wildcard should be the last field of path "regimes.*.code"`,
+		"regimes.translations.name":  `directus: unknown field "name" in path "regimes.translations.name" of directus.pathsHotel`,
+		"name.first":                 `directus: cannot read field "first" in path "name.first" of directus.pathsHotel from string`,
+		"owner.unknown_custom_field": `directus: unknown field "unknown_custom_field" in path "owner.unknown_custom_field" of directus.pathsHotel`,
+		"date_created.year":          `directus: unknown field "year" in path "date_created.year" of directus.pathsHotel`,
+		"regimes.price.amount":       `directus: cannot read field "amount" in path "regimes.price.amount" of directus.pathsHotel from float64`,
+	}
+	for path, msg := range invalid {
+		_, err := Path[pathsHotel](path)
+		require.EqualError(t, err, msg, path)
+	}
+}
+
+func TestPathPointerWrappers(t *testing.T) {
+	valid := []string{
+		"parent.price",
+		"parent.parent.id",
+		"price",
+		"translations.display_name",
+		"beds.size",
+	}
+	for _, path := range valid {
+		_, err := Path[pathsRoom](path)
+		require.NoError(t, err, path)
+	}
+
+	_, err := Path[pathsRoom]("parent.unknown")
+	require.EqualError(t, err, `directus: unknown field "unknown" in path "parent.unknown" of directus.pathsRoom`)
+}
+
+func TestMustPathPanics(t *testing.T) {
+	require.Panics(t, func() {
+		MustPath[pathsHotel]("foo")
+	})
+	require.NotPanics(t, func() {
+		MustPath[pathsHotel]("name")
+	})
+}
+
+func TestPathOptions(t *testing.T) {
+	var (
+		name = MustPath[pathsHotel]("name")
+		code = MustPath[pathsHotel]("regimes.code")
+	)
+	qs := readOptionsQuery(t,
+		WithPathFields(name, code),
+		WithPathSort(name, code.Desc()),
+		WithPathDeepFilter(MustPath[pathsHotel]("regimes"), Eq("code", "foo")),
+	)
+	require.Equal(t, []string{"name", "regimes.code"}, qs["fields[]"])
+	require.Equal(t, []string{"name", "-regimes.code"}, qs["sort[]"])
+	require.Equal(t, `{"regimes":{"_filter":{"code":{"_eq":"foo"}}}}`, qs.Get("deep"))
+}
+
+func TestPathOptionsOtherType(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Fail(t, "unexpected request", r.URL.String())
+	}))
+	defer s.Close()
+	items := NewItemsClient[pathsRegime](NewClient(s.URL, "local-token"), "regimes")
+
+	for _, opt := range []ReadOption{
+		WithPathFields(MustPath[pathsHotel]("name")),
+		WithPathSort(MustPath[pathsHotel]("name")),
+		WithPathDeepFilter(MustPath[pathsHotel]("regimes"), Eq("code", "foo")),
+	} {
+		_, err := items.List(context.Background(), opt)
+		require.EqualError(t, err, "directus: paths of directus.pathsHotel cannot be used to read items of directus.pathsRegime")
+	}
+}
+
+func readOptionsQuery(t *testing.T, opts ...ReadOption) url.Values {
+	items := NewItemsClient[pathsHotel](NewClient("http://localhost", ""), "hotels")
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/items/hotels", nil)
+	require.NoError(t, err)
+	require.NoError(t, items.applyOpts(req, opts...))
+	return req.URL.Query()
+}
+
+func TestWithAutoFields(t *testing.T) {
+	qs := readOptionsQuery(t, WithAutoFields())
+	require.Equal(t, []string{
+		"id",
+		"name",
+		"date_created",
+		"extra",
+		"meta",
+		"owner.id",
+		"owner.first_name",
+		"owner.last_name",
+		"owner.email",
+		"owner.role",
+		"owner.policies",
+		"owner.provider",
+		"owner.external_identifier",
+		"preset.*",
+		"regimes.user_created",
+		"regimes.code",
+		"regimes.price",
+		"regimes.hotel",
+		"regimes.translations.languages_code",
+		"regimes.translations.display_name",
+		"regimes.parent",
+		"regimes.previous",
+	}, qs["fields[]"])
+
+	require.Equal(t, []string{
+		"id",
+		"parent",
+		"price",
+		"translations.id",
+		"translations.languages_code",
+		"translations.display_name",
+		"beds.id",
+		"beds.size",
+	}, autoFields(reflect.TypeFor[pathsRoom](), "", nil))
+}
+
+func TestWithAutoFieldsItems(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, []string{"code", "status", "translations.languages_code", "translations.display_name"}, r.URL.Query()["fields[]"])
+		fmt.Fprint(w, `{"data": [{"code": "foo", "status": "published", "translations": [{"languages_code": "es", "display_name": "Foo"}]}]}`)
+	}))
+	defer s.Close()
+	items := NewItemsClient[RegimeWithTranslations](NewClient(s.URL, "local-token"), "regimes", WithAutoFields())
+
+	regimes, err := items.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, regimes, 1)
+	require.Equal(t, "Foo", regimes[0].Translations[0].Value().DisplayName)
+}
@@ -0,0 +1,378 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DesiredSchema describes the collections, fields and relations that should exist in the instance.
+type DesiredSchema struct {
+	// Collections to create or update. The fields declared inside each collection are reconciled like the rest of
+	// Fields.
+	Collections []*Collection
+
+	Fields    []*Field
+	Relations []*RelationDefinition
+
+	// RemovedCollections are deleted from the instance if they exist.
+	RemovedCollections []string
+}
+
+// ReconcileAction is the operation of a step of the plan.
+type ReconcileAction string
+
+const (
+	ReconcileCreate ReconcileAction = "create"
+	ReconcileUpdate ReconcileAction = "update"
+	ReconcileDelete ReconcileAction = "delete"
+)
+
+// ReconcileStep is a single change of the plan. Only one of Collection, Field or Relation is filled.
+type ReconcileStep struct {
+	Action ReconcileAction
+
+	Collection *Collection
+	Field      *Field
+	Relation   *RelationDefinition
+
+	// Changes are the paths of the properties that will be modified by an update.
+	Changes []string
+}
+
+// String describes the step in a single line.
+func (step *ReconcileStep) String() string {
+	var s string
+	switch {
+	case step.Collection != nil:
+		s = fmt.Sprintf("%s collection %s", step.Action, step.Collection.Collection)
+	case step.Field != nil:
+		s = fmt.Sprintf("%s field %s.%s", step.Action, step.Field.Collection, step.Field.Field)
+	case step.Relation != nil:
+		s = fmt.Sprintf("%s relation %s.%s", step.Action, step.Relation.Collection, step.Relation.Field)
+	}
+	if len(step.Changes) > 0 {
+		s += fmt.Sprintf(" (%s)", strings.Join(step.Changes, ", "))
+	}
+	return s
+}
+
+// ReconcilePlan is the list of changes needed to move the instance to the desired schema, in the order they should be
+// applied.
+type ReconcilePlan struct {
+	Steps []*ReconcileStep
+}
+
+// Empty returns true if the instance already has the desired schema.
+func (plan *ReconcilePlan) Empty() bool {
+	return len(plan.Steps) == 0
+}
+
+// String prints the steps of the plan, one per line, to review them before applying it.
+func (plan *ReconcilePlan) String() string {
+	if plan.Empty() {
+		return "no changes\n"
+	}
+	var sb strings.Builder
+	for _, step := range plan.Steps {
+		sb.WriteString(step.String())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// Reconciler moves the schema of an instance to a desired state.
+type Reconciler struct {
+	client *Client
+	prune  bool
+}
+
+// ReconcileOption configures a reconciler.
+type ReconcileOption func(r *Reconciler)
+
+// WithPrune deletes the fields and relations of the desired collections that are not described in the desired
+// schema. System fields and primary keys are never deleted.
+func WithPrune() ReconcileOption {
+	return func(r *Reconciler) {
+		r.prune = true
+	}
+}
+
+// NewReconciler prepares a reconciler that reads and modifies the schema with the client.
+func NewReconciler(client *Client, opts ...ReconcileOption) *Reconciler {
+	r := &Reconciler{client: client}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Plan reads the current schema of the instance and computes the changes needed to reach the desired one. It does
+// not modify anything. Properties with empty strings or null values in the desired schema are ignored when comparing
+// them with the current ones.
+//
+// Collections are created first, then fields and relations. Deletions are done at the end in the reverse order.
+func (r *Reconciler) Plan(ctx context.Context, desired *DesiredSchema) (*ReconcilePlan, error) {
+	collections, err := r.client.Collections.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := r.client.Fields.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	relations, err := r.client.Relations.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currentCollections := make(map[string]*Collection)
+	for _, collection := range collections {
+		currentCollections[collection.Collection] = collection
+	}
+	currentFields := make(map[string]*Field)
+	for _, field := range fields {
+		currentFields[field.Collection+"."+field.Field] = field
+	}
+	currentRelations := make(map[string]*RelationDefinition)
+	for _, relation := range relations {
+		currentRelations[relation.Collection+"."+relation.Field] = relation
+	}
+
+	var desiredFields []*Field
+	for _, collection := range desired.Collections {
+		for _, field := range collection.Fields {
+			if field.Collection == "" {
+				f := *field
+				f.Collection = collection.Collection
+				field = &f
+			}
+			desiredFields = append(desiredFields, field)
+		}
+	}
+	desiredFields = append(desiredFields, desired.Fields...)
+
+	plan := new(ReconcilePlan)
+	managed := make(map[string]bool)
+	created := make(map[string]bool)
+	for _, collection := range desired.Collections {
+		managed[collection.Collection] = true
+
+		current, ok := currentCollections[collection.Collection]
+		if !ok {
+			// The primary key should be sent when creating the collection, otherwise Directus adds its own.
+			create := *collection
+			create.Fields = nil
+			for _, field := range desiredFields {
+				if field.Collection == collection.Collection && field.Schema != nil && field.Schema.IsPrimaryKey {
+					create.Fields = append(create.Fields, field)
+					created[collection.Collection+"."+field.Field] = true
+				}
+			}
+			plan.Steps = append(plan.Steps, &ReconcileStep{Action: ReconcileCreate, Collection: &create})
+			continue
+		}
+
+		// Directus only updates the metadata of existing collections, comparing the schema would plan changes that
+		// can never be applied.
+		changes, err := schemaChanges(
+			&Collection{Meta: collection.Meta},
+			&Collection{Meta: current.Meta},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) > 0 {
+			update := &Collection{Collection: collection.Collection, Meta: collection.Meta}
+			plan.Steps = append(plan.Steps, &ReconcileStep{Action: ReconcileUpdate, Collection: update, Changes: changes})
+		}
+	}
+
+	wantedFields := make(map[string]bool)
+	for _, field := range desiredFields {
+		key := field.Collection + "." + field.Field
+		wantedFields[key] = true
+		if created[key] {
+			continue
+		}
+		current, ok := currentFields[key]
+		if !ok {
+			plan.Steps = append(plan.Steps, &ReconcileStep{Action: ReconcileCreate, Field: field})
+			continue
+		}
+		changes, err := schemaChanges(
+			&Field{Type: field.Type, Meta: field.Meta, Schema: field.Schema},
+			&Field{Type: current.Type, Meta: current.Meta, Schema: current.Schema},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) > 0 {
+			plan.Steps = append(plan.Steps, &ReconcileStep{Action: ReconcileUpdate, Field: field, Changes: changes})
+		}
+	}
+
+	wantedRelations := make(map[string]bool)
+	for _, relation := range desired.Relations {
+		key := relation.Collection + "." + relation.Field
+		wantedRelations[key] = true
+		current, ok := currentRelations[key]
+		if !ok {
+			plan.Steps = append(plan.Steps, &ReconcileStep{Action: ReconcileCreate, Relation: relation})
+			continue
+		}
+		changes, err := schemaChanges(
+			&RelationDefinition{RelatedCollection: relation.RelatedCollection, Schema: relation.Schema, Meta: relation.Meta},
+			&RelationDefinition{RelatedCollection: current.RelatedCollection, Schema: current.Schema, Meta: current.Meta},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) > 0 {
+			plan.Steps = append(plan.Steps, &ReconcileStep{Action: ReconcileUpdate, Relation: relation, Changes: changes})
+		}
+	}
+
+	if r.prune {
+		for _, relation := range relations {
+			key := relation.Collection + "." + relation.Field
+			if managed[relation.Collection] && !relation.Meta.System && !wantedRelations[key] {
+				plan.Steps = append(plan.Steps, &ReconcileStep{Action: ReconcileDelete, Relation: relation})
+			}
+		}
+		for _, field := range fields {
+			key := field.Collection + "." + field.Field
+			// Primary keys are kept because Directus adds its own when the desired collection does not declare it.
+			isPrimaryKey := field.Schema != nil && field.Schema.IsPrimaryKey
+			if managed[field.Collection] && !field.Meta.System && !isPrimaryKey && !wantedFields[key] {
+				plan.Steps = append(plan.Steps, &ReconcileStep{Action: ReconcileDelete, Field: field})
+			}
+		}
+	}
+	for _, name := range desired.RemovedCollections {
+		if managed[name] {
+			return nil, fmt.Errorf("directus: collection %q is both desired and removed", name)
+		}
+		if current, ok := currentCollections[name]; ok {
+			plan.Steps = append(plan.Steps, &ReconcileStep{Action: ReconcileDelete, Collection: current})
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply executes the steps of the plan in order. It stops at the first failure; the steps already applied are not
+// reverted, but computing and applying a new plan will continue where it stopped.
+func (r *Reconciler) Apply(ctx context.Context, plan *ReconcilePlan) error {
+	for _, step := range plan.Steps {
+		if err := r.applyStep(ctx, step); err != nil {
+			return fmt.Errorf("directus: cannot %s: %w", step, err)
+		}
+	}
+	return nil
+}
+
+// Reconcile computes the plan to reach the desired schema and applies it. It returns the applied plan.
+func (r *Reconciler) Reconcile(ctx context.Context, desired *DesiredSchema) (*ReconcilePlan, error) {
+	plan, err := r.Plan(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Apply(ctx, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (r *Reconciler) applyStep(ctx context.Context, step *ReconcileStep) error {
+	var err error
+	switch {
+	case step.Collection != nil:
+		switch step.Action {
+		case ReconcileCreate:
+			_, err = r.client.Collections.Create(ctx, step.Collection)
+		case ReconcileUpdate:
+			_, err = r.client.Collections.Patch(ctx, step.Collection.Collection, step.Collection)
+		case ReconcileDelete:
+			err = r.client.Collections.Delete(ctx, step.Collection.Collection)
+		}
+
+	case step.Field != nil:
+		switch step.Action {
+		case ReconcileCreate:
+			_, err = r.client.Fields.Create(ctx, step.Field)
+		case ReconcileUpdate:
+			_, err = r.client.Fields.Patch(ctx, step.Field)
+		case ReconcileDelete:
+			err = r.client.Fields.Delete(ctx, step.Field.Collection, step.Field.Field)
+		}
+
+	case step.Relation != nil:
+		switch step.Action {
+		case ReconcileCreate:
+			_, err = r.client.Relations.Create(ctx, step.Relation)
+		case ReconcileUpdate:
+			_, err = r.client.Relations.Patch(ctx, step.Relation)
+		case ReconcileDelete:
+			err = r.client.Relations.Delete(ctx, step.Relation.Collection, step.Relation.Field)
+		}
+	}
+	return err
+}
+
+// schemaChanges compares the JSON representation of both values and returns the paths of the desired properties that
+// have a different value in the current one.
+func schemaChanges(desired, current any) ([]string, error) {
+	d, err := jsonValue(desired)
+	if err != nil {
+		return nil, err
+	}
+	c, err := jsonValue(current)
+	if err != nil {
+		return nil, err
+	}
+	var changes []string
+	diffValues(&changes, "", d, c)
+	return changes, nil
+}
+
+func jsonValue(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot encode schema: %v", err)
+	}
+	var value any
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, fmt.Errorf("directus: cannot decode schema: %v", err)
+	}
+	return value, nil
+}
+
+func diffValues(changes *[]string, path string, desired, current any) {
+	switch desired := desired.(type) {
+	case nil:
+		return
+
+	case string:
+		if desired == "" {
+			return
+		}
+
+	case map[string]any:
+		current, _ := current.(map[string]any)
+		for _, key := range sortedKeys(desired) {
+			sub := key
+			if path != "" {
+				sub = path + "." + key
+			}
+			diffValues(changes, sub, desired[key], current[key])
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(desired, current) {
+		*changes = append(*changes, path)
+	}
+}
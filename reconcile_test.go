@@ -0,0 +1,280 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// schemaServer simulates the schema endpoints of an instance keeping the state in memory.
+type schemaServer struct {
+	mu          sync.Mutex
+	collections map[string]*Collection
+	fields      map[string]*Field
+	relations   map[string]*RelationDefinition
+	requests    []string
+}
+
+func newSchemaServer(t *testing.T) (*schemaServer, *httptest.Server) {
+	ss := &schemaServer{
+		collections: make(map[string]*Collection),
+		fields:      make(map[string]*Field),
+		relations:   make(map[string]*RelationDefinition),
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ss.mu.Lock()
+		defer ss.mu.Unlock()
+
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if r.Method != http.MethodGet {
+			ss.requests = append(ss.requests, r.Method+" "+r.URL.Path)
+		}
+		var reply any
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/collections":
+			list := []*Collection{}
+			for _, collection := range ss.collections {
+				list = append(list, collection)
+			}
+			reply = list
+		case r.Method == http.MethodGet && r.URL.Path == "/fields":
+			list := []*Field{}
+			for _, field := range ss.fields {
+				list = append(list, field)
+			}
+			reply = list
+		case r.Method == http.MethodGet && r.URL.Path == "/relations":
+			list := []*RelationDefinition{}
+			for _, relation := range ss.relations {
+				list = append(list, relation)
+			}
+			reply = list
+
+		case r.Method == http.MethodPost && r.URL.Path == "/collections":
+			collection := new(Collection)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(collection))
+			for _, field := range collection.Fields {
+				field.Collection = collection.Collection
+				ss.fields[collection.Collection+"."+field.Field] = field
+			}
+			collection.Fields = nil
+			ss.collections[collection.Collection] = collection
+			reply = collection
+		case r.Method == http.MethodPatch && parts[0] == "collections":
+			collection := new(Collection)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(collection))
+			ss.collections[parts[1]].Meta = collection.Meta
+			reply = ss.collections[parts[1]]
+		case r.Method == http.MethodDelete && parts[0] == "collections":
+			delete(ss.collections, parts[1])
+
+		case (r.Method == http.MethodPost || r.Method == http.MethodPatch) && parts[0] == "fields":
+			field := new(Field)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(field))
+			ss.fields[field.Collection+"."+field.Field] = field
+			reply = field
+		case r.Method == http.MethodDelete && parts[0] == "fields":
+			delete(ss.fields, parts[1]+"."+parts[2])
+
+		case (r.Method == http.MethodPost || r.Method == http.MethodPatch) && parts[0] == "relations":
+			relation := new(RelationDefinition)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(relation))
+			ss.relations[relation.Collection+"."+relation.Field] = relation
+			reply = relation
+		case r.Method == http.MethodDelete && parts[0] == "relations":
+			delete(ss.relations, parts[1]+"."+parts[2])
+
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+
+		if reply == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": reply}))
+	}))
+	return ss, s
+}
+
+func desiredHotels() *DesiredSchema {
+	return &DesiredSchema{
+		Collections: []*Collection{
+			{
+				Collection: "hotels",
+				Meta:       CollectionMeta{Icon: "hotel", Note: "Hotels of the chain"},
+				Schema:     &CollectionSchema{Name: "hotels"},
+				Fields: []*Field{
+					{Field: "id", Type: FieldTypeUUID, Meta: FieldMeta{Hidden: true, Special: []FieldSpecial{FieldSpecialUUID}}, Schema: &FieldSchema{IsPrimaryKey: true}},
+					{Field: "name", Type: FieldTypeString, Schema: &FieldSchema{MaxLength: 255}},
+				},
+			},
+			{
+				Collection: "regimes",
+				Schema:     &CollectionSchema{Name: "regimes"},
+				Fields: []*Field{
+					{Field: "code", Type: FieldTypeString, Schema: &FieldSchema{IsPrimaryKey: true}},
+				},
+			},
+		},
+		Fields: []*Field{
+			{Collection: "regimes", Field: "hotel", Type: FieldTypeUUID, Meta: FieldMeta{Special: []FieldSpecial{FieldSpecialManyToOne}}, Schema: &FieldSchema{IsNullable: true}},
+		},
+		Relations: []*RelationDefinition{
+			{Collection: "regimes", Field: "hotel", RelatedCollection: "hotels", Schema: RelationSchema{OnDelete: RelationActionSetNull}},
+		},
+	}
+}
+
+func TestReconcilerPlan(t *testing.T) {
+	_, s := newSchemaServer(t)
+	defer s.Close()
+	r := NewReconciler(NewClient(s.URL, "local-token"))
+
+	plan, err := r.Plan(context.Background(), desiredHotels())
+	require.NoError(t, err)
+	require.Equal(t, strings.Join([]string{
+		"create collection hotels",
+		"create collection regimes",
+		"create field hotels.name",
+		"create field regimes.hotel",
+		"create relation regimes.hotel",
+		"",
+	}, "\n"), plan.String())
+
+	require.Equal(t, "id", plan.Steps[0].Collection.Fields[0].Field)
+	require.Len(t, plan.Steps[0].Collection.Fields, 1)
+}
+
+func TestReconcilerApplyIdempotent(t *testing.T) {
+	ss, s := newSchemaServer(t)
+	defer s.Close()
+	r := NewReconciler(NewClient(s.URL, "local-token"))
+
+	_, err := r.Reconcile(context.Background(), desiredHotels())
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"POST /collections",
+		"POST /collections",
+		"POST /fields/hotels",
+		"POST /fields/regimes",
+		"POST /relations",
+	}, ss.requests)
+	require.Contains(t, ss.fields, "hotels.id")
+	require.Contains(t, ss.fields, "regimes.code")
+
+	plan, err := r.Plan(context.Background(), desiredHotels())
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+	require.Equal(t, "no changes\n", plan.String())
+}
+
+func TestReconcilerUpdate(t *testing.T) {
+	ss, s := newSchemaServer(t)
+	defer s.Close()
+	r := NewReconciler(NewClient(s.URL, "local-token"))
+
+	_, err := r.Reconcile(context.Background(), desiredHotels())
+	require.NoError(t, err)
+	ss.requests = nil
+
+	desired := desiredHotels()
+	desired.Collections[0].Meta.Note = "Hotels and resorts"
+	desired.Collections[0].Fields[1].Schema.MaxLength = 100
+	desired.Relations[0].Schema.OnDelete = RelationActionCascade
+	plan, err := r.Plan(context.Background(), desired)
+	require.NoError(t, err)
+	require.Equal(t, strings.Join([]string{
+		"update collection hotels (meta.note)",
+		"update field hotels.name (schema.max_length)",
+		"update relation regimes.hotel (schema.on_delete)",
+		"",
+	}, "\n"), plan.String())
+
+	require.NoError(t, r.Apply(context.Background(), plan))
+	require.Equal(t, []string{
+		"PATCH /collections/hotels",
+		"PATCH /fields/hotels/name",
+		"PATCH /relations/regimes/hotel",
+	}, ss.requests)
+	require.Equal(t, "Hotels and resorts", ss.collections["hotels"].Meta.Note)
+}
+
+func TestReconcilerCollectionSchema(t *testing.T) {
+	ss, s := newSchemaServer(t)
+	defer s.Close()
+	r := NewReconciler(NewClient(s.URL, "local-token"))
+
+	_, err := r.Reconcile(context.Background(), desiredHotels())
+	require.NoError(t, err)
+
+	desired := desiredHotels()
+	desired.Collections[0].Meta.Note = "Hotels and resorts"
+	desired.Collections[0].Schema.Comment = "Not applied by Directus"
+	plan, err := r.Reconcile(context.Background(), desired)
+	require.NoError(t, err)
+	require.Equal(t, "update collection hotels (meta.note)\n", plan.String())
+	require.Equal(t, "Hotels and resorts", ss.collections["hotels"].Meta.Note)
+
+	plan, err = r.Plan(context.Background(), desired)
+	require.NoError(t, err)
+	require.True(t, plan.Empty(), plan.String())
+}
+
+func TestReconcilerPrune(t *testing.T) {
+	ss, s := newSchemaServer(t)
+	defer s.Close()
+	ss.collections["legacy"] = &Collection{Collection: "legacy"}
+	ss.fields["hotels.stars"] = &Field{Collection: "hotels", Field: "stars", Type: "integer"}
+	ss.fields["hotels.user_created"] = &Field{Collection: "hotels", Field: "user_created", Type: FieldTypeUUID, Meta: FieldMeta{System: true}}
+	ss.fields["other.name"] = &Field{Collection: "other", Field: "name", Type: FieldTypeString}
+	ss.relations["regimes.previous"] = &RelationDefinition{Collection: "regimes", Field: "previous", RelatedCollection: "regimes"}
+	ss.collections["tags"] = &Collection{Collection: "tags", Schema: &CollectionSchema{Name: "tags"}}
+	ss.fields["tags.id"] = &Field{Collection: "tags", Field: "id", Type: "integer", Schema: &FieldSchema{IsPrimaryKey: true}}
+
+	desired := desiredHotels()
+	desired.Collections = append(desired.Collections, &Collection{Collection: "tags", Schema: &CollectionSchema{Name: "tags"}})
+	desired.RemovedCollections = []string{"legacy", "unknown"}
+
+	plan, err := NewReconciler(NewClient(s.URL, "local-token")).Plan(context.Background(), desired)
+	require.NoError(t, err)
+	require.NotContains(t, plan.String(), "delete field")
+	require.Contains(t, plan.String(), "delete collection legacy\n")
+
+	plan, err = NewReconciler(NewClient(s.URL, "local-token"), WithPrune()).Plan(context.Background(), desired)
+	require.NoError(t, err)
+	var deletes []string
+	for _, step := range plan.Steps {
+		if step.Action == ReconcileDelete {
+			deletes = append(deletes, step.String())
+		}
+	}
+	require.Equal(t, []string{
+		"delete relation regimes.previous",
+		"delete field hotels.stars",
+		"delete collection legacy",
+	}, deletes)
+}
+
+func TestReconcilerApplyError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"data": []}`)
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errors": [{"message": "You don't have permission to access this.", "extensions": {"code": "FORBIDDEN"}}]}`)
+	}))
+	defer s.Close()
+
+	_, err := NewReconciler(NewClient(s.URL, "local-token")).Reconcile(context.Background(), desiredHotels())
+	require.ErrorIs(t, err, ErrorCodeForbidden)
+	require.ErrorContains(t, err, "cannot create collection hotels")
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 
 	"github.com/perimeterx/marshmallow"
 )
@@ -62,6 +63,14 @@ func (r Relation[T]) String() string {
 	return "INVALID_RELATION"
 }
 
+func (r Relation[T]) pathTarget() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (r Relation[T]) relationTarget() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
 func (r Relation[T]) Empty() bool {
 	return r.value == nil && r.idstr == "" && r.idnum == 0
 }
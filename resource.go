@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 )
 
 type ResourceClient[T any, PK string | int64] struct {
@@ -33,17 +34,14 @@ func NewResourceClient[T any, PK string | int64](client *Client, endpoint string
 	return rc
 }
 
-func (rc *ResourceClient[T, PK]) List(ctx context.Context) ([]*T, error) {
+// List the resources. It accepts the same options as ItemsClient to select, sort and paginate them.
+func (rc *ResourceClient[T, PK]) List(ctx context.Context, opts ...ReadOption) ([]*T, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rc.client.urlf("/%s", rc.endpoint), nil)
 	if err != nil {
 		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
 	}
-	if len(rc.fields) > 0 {
-		q := req.URL.Query()
-		for _, field := range rc.fields {
-			q.Add("fields[]", field)
-		}
-		req.URL.RawQuery = q.Encode()
+	if err := rc.applyOpts(req, opts...); err != nil {
+		return nil, err
 	}
 	reply := struct {
 		Data []*T `json:"data"`
@@ -54,23 +52,81 @@ func (rc *ResourceClient[T, PK]) List(ctx context.Context) ([]*T, error) {
 	return reply.Data, nil
 }
 
-func (rc *ResourceClient[T, PK]) Get(ctx context.Context, id PK) (*T, error) {
+// Filter the resources in the server. Use List to read all of them.
+func (rc *ResourceClient[T, PK]) Filter(ctx context.Context, filter Filter, opts ...ReadOption) ([]*T, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("directus: filter is required")
+	}
+	f, err := FilterJSON(filter)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rc.client.urlf("/%s", rc.endpoint), nil)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	q := req.URL.Query()
+	q.Set("filter", f)
+	req.URL.RawQuery = q.Encode()
+	if err := rc.applyOpts(req, opts...); err != nil {
+		return nil, err
+	}
+	reply := struct {
+		Data []*T `json:"data"`
+	}{}
+	if err := rc.client.sendRequest(req, &reply); err != nil {
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+func (rc *ResourceClient[T, PK]) Get(ctx context.Context, id PK, opts ...ReadOption) (*T, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rc.client.urlf("/%s/%v", rc.endpoint, id), nil)
 	if err != nil {
 		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
 	}
-	if len(rc.fields) > 0 {
-		q := req.URL.Query()
+	if err := rc.applyOpts(req, opts...); err != nil {
+		return nil, err
+	}
+	reply := struct {
+		Data *T `json:"data"`
+	}{}
+	if err := rc.client.sendRequest(req, &reply); err != nil {
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+// applyOpts configures the request with the read options. The fields of WithResourceFields are requested when the
+// options do not select other fields.
+func (rc *ResourceClient[T, PK]) applyOpts(req *http.Request, opts ...ReadOption) error {
+	if err := applyReadOptions(req, reflect.TypeFor[T](), opts); err != nil {
+		return err
+	}
+	q := req.URL.Query()
+	if len(rc.fields) > 0 && !q.Has("fields[]") {
 		for _, field := range rc.fields {
 			q.Add("fields[]", field)
 		}
 		req.URL.RawQuery = q.Encode()
 	}
-	var reply T
-	if err := rc.client.sendRequest(req, &reply); err != nil {
-		return nil, err
+	return nil
+}
+
+// resourcedo encodes the request and sends it to the URL with the fields of the client.
+func (rc *ResourceClient[T, PK]) resourcedo(ctx context.Context, method, url string, request, reply any) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(request); err != nil {
+		return fmt.Errorf("directus: cannot encode request: %v", err)
 	}
-	return &reply, nil
+	req, err := http.NewRequestWithContext(ctx, method, url, &buf)
+	if err != nil {
+		return fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	if err := rc.applyOpts(req); err != nil {
+		return err
+	}
+	return rc.client.sendRequest(req, reply)
 }
 
 func (rc *ResourceClient[T, PK]) Delete(ctx context.Context, id PK) error {
@@ -90,25 +146,10 @@ func (rc *ResourceClient[T, PK]) Create(ctx context.Context, item *T) (*T, error
 		before.BeforeDirectus()
 	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(item); err != nil {
-		return nil, fmt.Errorf("directus: cannot encode request: %v", err)
-	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rc.client.urlf("/%s", rc.endpoint), &buf)
-	if err != nil {
-		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
-	}
-	if len(rc.fields) > 0 {
-		q := req.URL.Query()
-		for _, field := range rc.fields {
-			q.Add("fields[]", field)
-		}
-		req.URL.RawQuery = q.Encode()
-	}
 	reply := struct {
 		Data *T `json:"data"`
 	}{}
-	if err := rc.client.sendRequest(req, &reply); err != nil {
+	if err := rc.resourcedo(ctx, http.MethodPost, rc.client.urlf("/%s", rc.endpoint), item, &reply); err != nil {
 		return nil, err
 	}
 	return reply.Data, nil
@@ -119,25 +160,10 @@ func (rc *ResourceClient[T, PK]) Patch(ctx context.Context, id PK, item *T) (*T,
 		before.BeforeDirectus()
 	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(item); err != nil {
-		return nil, fmt.Errorf("directus: cannot encode request: %v", err)
-	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, rc.client.urlf("/%s/%v", rc.endpoint, id), &buf)
-	if err != nil {
-		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
-	}
-	if len(rc.fields) > 0 {
-		q := req.URL.Query()
-		for _, field := range rc.fields {
-			q.Add("fields[]", field)
-		}
-		req.URL.RawQuery = q.Encode()
-	}
 	reply := struct {
 		Data *T `json:"data"`
 	}{}
-	if err := rc.client.sendRequest(req, &reply); err != nil {
+	if err := rc.resourcedo(ctx, http.MethodPatch, rc.client.urlf("/%s/%v", rc.endpoint, id), item, &reply); err != nil {
 		return nil, err
 	}
 	return reply.Data, nil
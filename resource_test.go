@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,3 +23,70 @@ func TestResourcesCollectionsList(t *testing.T) {
 	require.NoError(t, err)
 	fmt.Println(string(e))
 }
+
+func TestResourceFilter(t *testing.T) {
+	var q url.Values
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/users", r.URL.Path)
+		q = r.URL.Query()
+		fmt.Fprint(w, `{"data": [{"id": "u1", "email": "foo@example.com", "role": "r1"}]}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	users, err := client.Users.Filter(context.Background(), Eq("role", "r1"), WithFields("id", "email", "role"), WithSort("email"), WithLimit(10))
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, "foo@example.com", users[0].Email)
+
+	require.JSONEq(t, `{"role": {"_eq": "r1"}}`, q.Get("filter"))
+	require.Equal(t, []string{"id", "email", "role"}, q["fields[]"])
+	require.Equal(t, []string{"email"}, q["sort[]"])
+	require.Equal(t, "10", q.Get("limit"))
+}
+
+func TestResourceFilterRequired(t *testing.T) {
+	client := NewClient("http://localhost", "local-token")
+	_, err := client.Users.Filter(context.Background(), nil)
+	require.EqualError(t, err, "directus: filter is required")
+}
+
+func TestResourceGet(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/presets/3", r.URL.Path)
+		fmt.Fprint(w, `{"data": {"id": 3, "collection": "regimes", "layout": "tabular"}}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	preset, err := client.Presets.Get(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), preset.ID)
+	require.Equal(t, "regimes", preset.Collection)
+	require.Equal(t, "tabular", preset.Layout.Value)
+}
+
+func TestResourceGetFields(t *testing.T) {
+	var u string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u = r.URL.String()
+		fmt.Fprint(w, `{"data": {"id": "r1", "name": "Editor", "policies": [{"id": "a1", "policy": "p1"}]}}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	role, err := client.Roles.Get(context.Background(), "r1")
+	require.NoError(t, err)
+	require.Equal(t, "Editor", role.Name)
+	require.Equal(t, []RolePolicy{{ID: "p1", accessID: "a1"}}, role.Policies)
+	got, err := url.QueryUnescape(u)
+	require.NoError(t, err)
+	require.Equal(t, `/roles/r1?fields[]=*&fields[]=policies.id&fields[]=policies.policy`, got)
+
+	_, err = client.Roles.Get(context.Background(), "r1", WithFields("name"), WithDeepLimit("policies", 5))
+	require.NoError(t, err)
+	got, err = url.QueryUnescape(u)
+	require.NoError(t, err)
+	require.Equal(t, `/roles/r1?deep[policies][_limit]=5&fields[]=name`, got)
+}
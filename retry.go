@@ -0,0 +1,135 @@
+package directus
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how requests that fail with transient errors are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts of each request, including the first one. By default it is 3.
+	MaxAttempts int
+
+	// InitialBackoff is the wait before the first retry. It doubles with each attempt, with some random jitter.
+	// By default it is 500ms.
+	InitialBackoff time.Duration
+
+	// MaxBackoff limits the wait between attempts. By default it is 10s.
+	MaxBackoff time.Duration
+
+	// RetryNonIdempotent retries POST and PATCH requests too. Only enable it if duplicated writes are not a problem,
+	// as the server could have processed the failed request anyway.
+	RetryNonIdempotent bool
+}
+
+// WithRetry retries the requests that fail with network errors or with a 429, 502, 503 or 504 status code. Only GET
+// and DELETE requests are retried unless the policy says otherwise. The Retry-After header sent by the server is
+// honored and no retry is attempted if it would exceed the deadline of the request context. Middlewares configured in
+// the client see a single request even if it is retried multiple times.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(client *Client) {
+		if policy.MaxAttempts == 0 {
+			policy.MaxAttempts = 3
+		}
+		if policy.InitialBackoff == 0 {
+			policy.InitialBackoff = 500 * time.Millisecond
+		}
+		if policy.MaxBackoff == 0 {
+			policy.MaxBackoff = 10 * time.Second
+		}
+		client.retry = &policy
+	}
+}
+
+type retryTransport struct {
+	next   http.RoundTripper
+	policy RetryPolicy
+}
+
+func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !rt.retryable(req) {
+		return rt.next.RoundTrip(req)
+	}
+
+	backoff := rt.policy.InitialBackoff
+	attempt := req
+	for i := 1; ; i++ {
+		resp, err := rt.next.RoundTrip(attempt)
+		if i >= rt.policy.MaxAttempts || !retryableResponse(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		wait := backoff/2 + rand.N(backoff/2+1)
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				wait = after
+			}
+		}
+		if deadline, ok := req.Context().Deadline(); ok && time.Until(deadline) < wait {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		backoff = min(backoff*2, rt.policy.MaxBackoff)
+		attempt = req.Clone(req.Context())
+		if req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attempt.Body = body
+		}
+	}
+}
+
+func (rt *retryTransport) retryable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	case http.MethodPost, http.MethodPatch:
+		return rt.policy.RetryNonIdempotent
+	}
+	return false
+}
+
+func retryableResponse(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
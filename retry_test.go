@@ -0,0 +1,116 @@
+package directus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTransientErrors(t *testing.T) {
+	var calls int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"data": {"version": "11.0.2"}}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token", WithRetry(RetryPolicy{InitialBackoff: time.Millisecond}))
+
+	info, err := client.Server.Info(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "11.0.2", info.Version)
+	require.Equal(t, 3, calls)
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	var calls int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token", WithRetry(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+
+	_, err := client.Server.Info(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func TestRetryAfter(t *testing.T) {
+	var calls []time.Time
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, time.Now())
+		if len(calls) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"data": {"version": "11.0.2"}}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token", WithRetry(RetryPolicy{InitialBackoff: time.Millisecond}))
+
+	_, err := client.Server.Info(context.Background())
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+	require.GreaterOrEqual(t, calls[1].Sub(calls[0]), time.Second)
+}
+
+func TestRetryAfterExceedsDeadline(t *testing.T) {
+	var calls int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token", WithRetry(RetryPolicy{InitialBackoff: time.Millisecond}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := client.Server.Info(ctx)
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	var calls int
+	var bodies []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var buf [64]byte
+		n, _ := r.Body.Read(buf[:])
+		bodies = append(bodies, string(buf[:n]))
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"data": {"id": "1"}}`)
+	}))
+	defer s.Close()
+
+	client := NewClient(s.URL, "local-token", WithRetry(RetryPolicy{InitialBackoff: time.Millisecond}))
+	_, err := NewItemsClient[Regime](client, "regimes").Create(context.Background(), &Regime{Status: "draft"})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+
+	calls = 0
+	bodies = nil
+	client = NewClient(s.URL, "local-token", WithRetry(RetryPolicy{InitialBackoff: time.Millisecond, RetryNonIdempotent: true}))
+	regime, err := NewItemsClient[Regime](client, "regimes").Create(context.Background(), &Regime{Status: "draft"})
+	require.NoError(t, err)
+	require.Equal(t, "1", regime.ID)
+	require.Equal(t, 2, calls)
+	require.Equal(t, bodies[0], bodies[1])
+}
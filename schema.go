@@ -0,0 +1,261 @@
+package directus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/perimeterx/marshmallow"
+)
+
+type clientSchema struct {
+	client *Client
+}
+
+// SchemaSnapshot is the full data model of an instance: its collections, fields and relations.
+type SchemaSnapshot struct {
+	Version  int64  `json:"version"`
+	Directus string `json:"directus"`
+	Vendor   string `json:"vendor,omitempty"`
+
+	Collections []*Collection         `json:"collections"`
+	Fields      []*Field              `json:"fields"`
+	Relations   []*RelationDefinition `json:"relations"`
+
+	Unknown map[string]any `json:"-"`
+}
+
+func (snapshot *SchemaSnapshot) UnmarshalJSON(data []byte) error {
+	values, err := marshmallow.Unmarshal(data, snapshot, marshmallow.WithExcludeKnownFieldsFromMap(true))
+	if err != nil {
+		return err
+	}
+	snapshot.Unknown = values
+	return nil
+}
+
+func (snapshot *SchemaSnapshot) MarshalJSON() ([]byte, error) {
+	type alias SchemaSnapshot
+	base, err := json.Marshal((*alias)(snapshot))
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]any)
+	for k, v := range snapshot.Unknown {
+		m[k] = v
+	}
+	if err := json.Unmarshal(base, &m); err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// SchemaDiff contains the changes needed to move an instance to the schema of a snapshot. It should be applied
+// without modifications to the same instance that generated it.
+type SchemaDiff struct {
+	// Hash identifies the schema of the instance when the diff was generated. Applying the diff fails if the schema
+	// has changed in the meantime.
+	Hash string `json:"hash"`
+
+	Diff SchemaDiffChanges `json:"diff"`
+}
+
+// SchemaDiffChanges groups the changes of a diff by the kind of element they modify.
+type SchemaDiffChanges struct {
+	Collections []*CollectionDiff `json:"collections"`
+	Fields      []*FieldDiff      `json:"fields"`
+	Relations   []*RelationDiff   `json:"relations"`
+}
+
+func (changes SchemaDiffChanges) MarshalJSON() ([]byte, error) {
+	// Directus expects arrays when applying the diff, even if there are no changes of a kind.
+	type alias SchemaDiffChanges
+	if changes.Collections == nil {
+		changes.Collections = []*CollectionDiff{}
+	}
+	if changes.Fields == nil {
+		changes.Fields = []*FieldDiff{}
+	}
+	if changes.Relations == nil {
+		changes.Relations = []*RelationDiff{}
+	}
+	return json.Marshal(alias(changes))
+}
+
+// CollectionDiff are the changes of a single collection.
+type CollectionDiff struct {
+	Collection string          `json:"collection"`
+	Diff       []*SchemaChange `json:"diff"`
+}
+
+// FieldDiff are the changes of a single field.
+type FieldDiff struct {
+	Collection string          `json:"collection"`
+	Field      string          `json:"field"`
+	Diff       []*SchemaChange `json:"diff"`
+}
+
+// RelationDiff are the changes of a single relation.
+type RelationDiff struct {
+	Collection        string          `json:"collection"`
+	Field             string          `json:"field"`
+	RelatedCollection string          `json:"related_collection"`
+	Diff              []*SchemaChange `json:"diff"`
+}
+
+// SchemaChangeKind is the type of modification of a schema change.
+type SchemaChangeKind string
+
+const (
+	// SchemaChangeNew adds a new element or property.
+	SchemaChangeNew SchemaChangeKind = "N"
+
+	// SchemaChangeDeleted removes an element or property.
+	SchemaChangeDeleted SchemaChangeKind = "D"
+
+	// SchemaChangeEdited modifies the value of a property.
+	SchemaChangeEdited SchemaChangeKind = "E"
+
+	// SchemaChangeArray modifies an element of an array. The change of the element is in Item.
+	SchemaChangeArray SchemaChangeKind = "A"
+)
+
+// SchemaChange is a single modification of the schema. The values are kept as raw JSON to send them back unmodified
+// when applying the diff.
+type SchemaChange struct {
+	Kind SchemaChangeKind `json:"kind"`
+
+	// Path is the list of property names and array indexes of the modified value. It is empty when the change
+	// affects the whole element.
+	Path []any `json:"path,omitempty"`
+
+	// LHS is the current value in the instance.
+	LHS json.RawMessage `json:"lhs,omitempty"`
+
+	// RHS is the value in the snapshot.
+	RHS json.RawMessage `json:"rhs,omitempty"`
+
+	// Index and Item describe the change of array elements.
+	Index *int64        `json:"index,omitempty"`
+	Item  *SchemaChange `json:"item,omitempty"`
+}
+
+// String describes the change in a single line to review it.
+func (change *SchemaChange) String() string {
+	var path []string
+	for _, p := range change.Path {
+		path = append(path, fmt.Sprint(p))
+	}
+	switch change.Kind {
+	case SchemaChangeNew:
+		return fmt.Sprintf("+ %s: %s", strings.Join(path, "."), change.RHS)
+	case SchemaChangeDeleted:
+		return fmt.Sprintf("- %s: %s", strings.Join(path, "."), change.LHS)
+	case SchemaChangeEdited:
+		return fmt.Sprintf("~ %s: %s -> %s", strings.Join(path, "."), change.LHS, change.RHS)
+	case SchemaChangeArray:
+		if change.Item == nil || change.Index == nil {
+			return fmt.Sprintf("~ %s", strings.Join(path, "."))
+		}
+		item := *change.Item
+		item.Path = append(slices.Clone(change.Path), *change.Index)
+		return item.String()
+	default:
+		return fmt.Sprintf("%s %s", change.Kind, strings.Join(path, "."))
+	}
+}
+
+type schemaOptions struct {
+	force bool
+}
+
+// SchemaOption configures how a diff is generated.
+type SchemaOption func(opts *schemaOptions)
+
+// WithSchemaForce generates the diff even if the snapshot was taken from a different version of Directus or a
+// different database vendor.
+func WithSchemaForce() SchemaOption {
+	return func(opts *schemaOptions) {
+		opts.force = true
+	}
+}
+
+// Snapshot reads the current schema of the instance.
+func (cr *clientSchema) Snapshot(ctx context.Context) (*SchemaSnapshot, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cr.client.urlf("/schema/snapshot"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	reply := struct {
+		Data *SchemaSnapshot `json:"data"`
+	}{}
+	if err := cr.client.sendRequest(req, &reply); err != nil {
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+// Diff computes the changes needed to move the instance to the schema of the snapshot. It returns nil if there are no
+// differences.
+func (cr *clientSchema) Diff(ctx context.Context, snapshot *SchemaSnapshot, opts ...SchemaOption) (*SchemaDiff, error) {
+	var options schemaOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	u, err := url.Parse(cr.client.urlf("/schema/diff"))
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot parse url: %v", err)
+	}
+	if options.force {
+		q := u.Query()
+		q.Set("force", "true")
+		u.RawQuery = q.Encode()
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(snapshot); err != nil {
+		return nil, fmt.Errorf("directus: cannot encode request: %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), &buf)
+	if err != nil {
+		return nil, fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	reply := struct {
+		Data *SchemaDiff `json:"data"`
+	}{}
+	if err := cr.client.sendRequest(req, &reply); err != nil {
+		if errors.Is(err, ErrEmpty) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+// Apply executes the changes of the diff in the instance. It fails if the schema of the instance has changed since the
+// diff was generated.
+func (cr *clientSchema) Apply(ctx context.Context, diff *SchemaDiff) error {
+	if diff == nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(diff); err != nil {
+		return fmt.Errorf("directus: cannot encode request: %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cr.client.urlf("/schema/apply"), &buf)
+	if err != nil {
+		return fmt.Errorf("directus: cannot prepare request: %v", err)
+	}
+	if err := cr.client.sendRequest(req, nil); err != nil && !errors.Is(err, ErrEmpty) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSnapshot = `{
+	"version": 1,
+	"directus": "11.1.0",
+	"vendor": "postgres",
+	"systemFields": [],
+	"collections": [
+		{"collection": "regimes", "meta": {"collection": "regimes", "icon": "hotel", "hidden": false, "singleton": false}, "schema": {"name": "regimes"}}
+	],
+	"fields": [
+		{"collection": "regimes", "field": "code", "type": "string", "meta": null, "schema": {"name": "code", "data_type": "character varying", "is_nullable": false}},
+		{"collection": "regimes", "field": "hotel", "type": "uuid", "meta": {"special": ["m2o"]}, "schema": {"name": "hotel", "data_type": "uuid", "is_nullable": true}}
+	],
+	"relations": [
+		{"collection": "regimes", "field": "hotel", "related_collection": "hotels", "meta": {"id": 4}, "schema": {"table": "regimes", "column": "hotel", "on_delete": "SET NULL"}}
+	]
+}`
+
+func TestSchemaSnapshot(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/schema/snapshot", r.URL.Path)
+		fmt.Fprintf(w, `{"data": %s}`, testSnapshot)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	snapshot, err := client.Schema.Snapshot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "11.1.0", snapshot.Directus)
+	require.Equal(t, "postgres", snapshot.Vendor)
+	require.Contains(t, snapshot.Unknown, "systemFields")
+
+	require.Len(t, snapshot.Collections, 1)
+	require.Equal(t, "regimes", snapshot.Collections[0].Collection)
+	require.Equal(t, Icon("hotel"), snapshot.Collections[0].Meta.Icon)
+
+	require.Len(t, snapshot.Fields, 2)
+	require.Equal(t, "code", snapshot.Fields[0].Field)
+	require.Equal(t, FieldTypeString, snapshot.Fields[0].Type)
+	require.Equal(t, "character varying", snapshot.Fields[0].Schema.DataType)
+	require.True(t, snapshot.Fields[1].Meta.HasSpecial(FieldSpecialManyToOne))
+
+	require.Len(t, snapshot.Relations, 1)
+	require.Equal(t, "hotels", snapshot.Relations[0].RelatedCollection)
+	require.Equal(t, RelationActionSetNull, snapshot.Relations[0].Schema.OnDelete)
+}
+
+func TestSchemaDiff(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/schema/diff", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("force"))
+
+		var snapshot SchemaSnapshot
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&snapshot))
+		require.Equal(t, "11.1.0", snapshot.Directus)
+		require.Contains(t, snapshot.Unknown, "systemFields")
+
+		fmt.Fprint(w, `{"data": {
+			"hash": "abc123",
+			"diff": {
+				"collections": [{"collection": "regimes", "diff": [{"kind": "N", "rhs": {"collection": "regimes"}}]}],
+				"fields": [{"collection": "regimes", "field": "code", "diff": [{"kind": "E", "path": ["schema", "max_length"], "lhs": 100, "rhs": 255}]}],
+				"relations": [{"collection": "regimes", "field": "hotel", "related_collection": "hotels", "diff": [{"kind": "A", "path": ["meta", "one_allowed_collections"], "index": 1, "item": {"kind": "D", "lhs": "rooms"}}]}]
+			}
+		}}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	var snapshot SchemaSnapshot
+	require.NoError(t, json.Unmarshal([]byte(testSnapshot), &snapshot))
+	diff, err := client.Schema.Diff(context.Background(), &snapshot, WithSchemaForce())
+	require.NoError(t, err)
+
+	require.Equal(t, "abc123", diff.Hash)
+	require.Len(t, diff.Diff.Collections, 1)
+	require.Equal(t, SchemaChangeNew, diff.Diff.Collections[0].Diff[0].Kind)
+	require.Len(t, diff.Diff.Fields, 1)
+	require.Equal(t, "~ schema.max_length: 100 -> 255", diff.Diff.Fields[0].Diff[0].String())
+	require.Len(t, diff.Diff.Relations, 1)
+	require.Equal(t, `- meta.one_allowed_collections.1: "rooms"`, diff.Diff.Relations[0].Diff[0].String())
+}
+
+func TestSchemaDiffEmpty(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.URL.Query().Get("force"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	diff, err := client.Schema.Diff(context.Background(), new(SchemaSnapshot))
+	require.NoError(t, err)
+	require.Nil(t, diff)
+}
+
+func TestSchemaApply(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/schema/apply", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"hash": "abc123",
+			"diff": {
+				"collections": [],
+				"fields": [{"collection": "regimes", "field": "code", "diff": [{"kind": "E", "path": ["schema", "max_length"], "lhs": 100, "rhs": 255}]}],
+				"relations": []
+			}
+		}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	diff := &SchemaDiff{
+		Hash: "abc123",
+		Diff: SchemaDiffChanges{
+			Fields: []*FieldDiff{
+				{
+					Collection: "regimes",
+					Field:      "code",
+					Diff: []*SchemaChange{
+						{Kind: SchemaChangeEdited, Path: []any{"schema", "max_length"}, LHS: json.RawMessage("100"), RHS: json.RawMessage("255")},
+					},
+				},
+			},
+		},
+	}
+	require.NoError(t, client.Schema.Apply(context.Background(), diff))
+}
+
+func TestSchemaApplyOutdated(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors": [{"message": "Provided hash does not match the current instance's schema hash.", "extensions": {"code": "INVALID_PAYLOAD"}}]}`)
+	}))
+	defer s.Close()
+	client := NewClient(s.URL, "local-token")
+
+	err := client.Schema.Apply(context.Background(), &SchemaDiff{Hash: "outdated"})
+	require.ErrorIs(t, err, ErrorCodeInvalidPayload)
+}
@@ -0,0 +1,219 @@
+package directus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// defaultLanguageField is the field of the translations that contains the language if the type does not choose
+// another one.
+const defaultLanguageField = "languages_code"
+
+// languageFielder can be implemented by the translation types to read the language from a field other than
+// languages_code.
+type languageFielder interface {
+	LanguageField() string
+}
+
+// Translations is the translations relation of an item, with one row of type T for each language. The rows are
+// indexed by the language field, languages_code by default. Implement the method LanguageField() string in T to use
+// another field.
+//
+// When encoded it sends only the changes made with Set and Delete, like Alterations. The primary key of the rows, the
+// "id" field, should be requested to update or delete existing translations. The zero value is ready to add the
+// translations of a new item.
+type Translations[T any] struct {
+	rows    []*translationRow[T]
+	deleted []any
+}
+
+type translationRow[T any] struct {
+	item    *T
+	lang    string
+	pk      any
+	changed bool
+}
+
+// Lookup returns the translation of the first language of the chain that exists. Regional languages fall back to
+// their base language before trying the next one of the chain, so "es-ES" will try "es-ES" and then "es". Pass the
+// default language of the project at the end to use it as the last resort.
+func (t Translations[T]) Lookup(langs ...string) (*T, bool) {
+	for _, lang := range langs {
+		if row := t.find(lang); row != nil {
+			return row.item, true
+		}
+		if base, _, ok := strings.Cut(lang, "-"); ok {
+			if row := t.find(base); row != nil {
+				return row.item, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Languages returns the languages that have a translation.
+func (t Translations[T]) Languages() []string {
+	langs := make([]string, len(t.rows))
+	for i, row := range t.rows {
+		langs[i] = row.lang
+	}
+	return langs
+}
+
+// All returns the translations of every language.
+func (t Translations[T]) All() []*T {
+	items := make([]*T, len(t.rows))
+	for i, row := range t.rows {
+		items[i] = row.item
+	}
+	return items
+}
+
+// Set adds the translation, or replaces the existing one with the same language.
+func (t *Translations[T]) Set(item *T) error {
+	lang, err := translationLanguage(item)
+	if err != nil {
+		return err
+	}
+	if row := t.find(lang); row != nil {
+		row.item = item
+		row.changed = true
+		return nil
+	}
+	t.rows = append(t.rows, &translationRow[T]{item: item, lang: lang, changed: true})
+	return nil
+}
+
+// Delete removes the translation of the language if it exists.
+func (t *Translations[T]) Delete(lang string) {
+	for i, row := range t.rows {
+		if row.lang == lang {
+			if row.pk != nil {
+				t.deleted = append(t.deleted, row.pk)
+			}
+			t.rows = append(t.rows[:i], t.rows[i+1:]...)
+			return
+		}
+	}
+}
+
+func (t Translations[T]) find(lang string) *translationRow[T] {
+	for _, row := range t.rows {
+		if row.lang == lang {
+			return row
+		}
+	}
+	return nil
+}
+
+func (t Translations[T]) pathTarget() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (t Translations[T]) relationTarget() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (t Translations[T]) MarshalJSON() ([]byte, error) {
+	alterations := struct {
+		Create []any `json:"create,omitempty"`
+		Update []any `json:"update,omitempty"`
+		Delete []any `json:"delete,omitempty"`
+	}{
+		Delete: t.deleted,
+	}
+	for _, row := range t.rows {
+		if !row.changed {
+			continue
+		}
+		if row.pk == nil {
+			alterations.Create = append(alterations.Create, row.item)
+			continue
+		}
+
+		// The new value may not have the primary key of the row it replaces.
+		b, err := json.Marshal(row.item)
+		if err != nil {
+			return nil, err
+		}
+		var values map[string]any
+		if err := json.Unmarshal(b, &values); err != nil {
+			return nil, err
+		}
+		values[relatedPrimaryKey] = row.pk
+		alterations.Update = append(alterations.Update, values)
+	}
+	return json.Marshal(alterations)
+}
+
+func (t *Translations[T]) UnmarshalJSON(data []byte) error {
+	*t = Translations[T]{}
+	if string(data) == "null" {
+		return nil
+	}
+
+	var rows []json.RawMessage
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return fmt.Errorf("directus: cannot decode translations: %v", err)
+	}
+	field := languageField[T]()
+	for _, raw := range rows {
+		// Rows not expanded only contain the primary key and cannot be looked up.
+		if raw = bytes.TrimSpace(raw); len(raw) == 0 || raw[0] != '{' {
+			continue
+		}
+
+		var values map[string]any
+		if err := decodeNumber(raw, &values); err != nil {
+			return fmt.Errorf("directus: cannot decode translations: %v", err)
+		}
+		item := new(T)
+		if err := json.Unmarshal(raw, item); err != nil {
+			return fmt.Errorf("directus: cannot decode translations: %v", err)
+		}
+		row := &translationRow[T]{
+			item: item,
+			lang: languageValue(values[field]),
+		}
+		if pk, ok := values[relatedPrimaryKey]; ok && pk != nil {
+			row.pk = pk
+		}
+		t.rows = append(t.rows, row)
+	}
+	return nil
+}
+
+func languageField[T any]() string {
+	if fielder, ok := any(new(T)).(languageFielder); ok {
+		return fielder.LanguageField()
+	}
+	return defaultLanguageField
+}
+
+func translationLanguage[T any](item *T) (string, error) {
+	field := languageField[T]()
+	value, err := itemField(item, field)
+	if err != nil {
+		return "", fmt.Errorf("directus: translation without language: %w", err)
+	}
+	lang := languageValue(value)
+	if lang == "" {
+		return "", fmt.Errorf("directus: translation without language in field %q", field)
+	}
+	return lang, nil
+}
+
+// languageValue extracts the code of the language, even if the relation with the languages collection is expanded.
+func languageValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case map[string]any:
+		return languageValue(value["code"])
+	default:
+		return fmt.Sprint(value)
+	}
+}
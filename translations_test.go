@@ -0,0 +1,146 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type RegimeLocalized struct {
+	ID           int64                          `json:"id,omitempty"`
+	Code         string                         `json:"code"`
+	Translations Translations[RegimeLocalizedT] `json:"translations"`
+}
+
+type RegimeLocalizedT struct {
+	ID          int64  `json:"id,omitempty"`
+	Lang        string `json:"languages_code"`
+	DisplayName string `json:"display_name"`
+}
+
+type PageTranslation struct {
+	Language Relation[map[string]any] `json:"language"`
+	Title    string                   `json:"title"`
+}
+
+func (PageTranslation) LanguageField() string {
+	return "language"
+}
+
+func TestTranslationsLookup(t *testing.T) {
+	var regime RegimeLocalized
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": 1,
+		"code": "foo",
+		"translations": [
+			{"id": 10, "languages_code": "en-US", "display_name": "Room only"},
+			{"id": 11, "languages_code": "es", "display_name": "Solo alojamiento"},
+			12
+		]
+	}`), &regime))
+	require.Equal(t, []string{"en-US", "es"}, regime.Translations.Languages())
+
+	translation, ok := regime.Translations.Lookup("es-ES", "en-US")
+	require.True(t, ok)
+	require.Equal(t, "Solo alojamiento", translation.DisplayName)
+
+	translation, ok = regime.Translations.Lookup("fr-FR", "en-US")
+	require.True(t, ok)
+	require.Equal(t, "Room only", translation.DisplayName)
+
+	_, ok = regime.Translations.Lookup("fr-FR")
+	require.False(t, ok)
+}
+
+func TestTranslationsLanguageField(t *testing.T) {
+	var translations Translations[PageTranslation]
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"id": 1, "language": {"code": "ca", "name": "Català"}, "title": "Inici"},
+		{"id": 2, "language": "es", "title": "Inicio"}
+	]`), &translations))
+	require.Equal(t, []string{"ca", "es"}, translations.Languages())
+
+	translation, ok := translations.Lookup("ca-ES")
+	require.True(t, ok)
+	require.Equal(t, "Inici", translation.Title)
+
+	require.NoError(t, translations.Set(&PageTranslation{Language: NewRelationID[map[string]any]("en"), Title: "Home"}))
+	require.Equal(t, []string{"ca", "es", "en"}, translations.Languages())
+}
+
+func TestTranslationsSetWithoutLanguage(t *testing.T) {
+	var translations Translations[RegimeLocalizedT]
+	require.Error(t, translations.Set(&RegimeLocalizedT{DisplayName: "Room only"}))
+}
+
+func TestTranslationsAlterations(t *testing.T) {
+	var regime RegimeLocalized
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": 1,
+		"code": "foo",
+		"translations": [
+			{"id": 10, "languages_code": "en-US", "display_name": "Room only"},
+			{"id": 11, "languages_code": "es-ES", "display_name": "Solo alojamiento"},
+			{"id": 12, "languages_code": "fr-FR", "display_name": "Chambre seule"}
+		]
+	}`), &regime))
+
+	require.NoError(t, regime.Translations.Set(&RegimeLocalizedT{Lang: "es-ES", DisplayName: "Sólo alojamiento"}))
+	require.NoError(t, regime.Translations.Set(&RegimeLocalizedT{Lang: "ca-ES", DisplayName: "Només allotjament"}))
+	regime.Translations.Delete("fr-FR")
+	regime.Translations.Delete("de-DE")
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+		require.Equal(t, "/items/regimes/1", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"id": 1,
+			"code": "foo",
+			"translations": {
+				"create": [{"languages_code": "ca-ES", "display_name": "Només allotjament"}],
+				"update": [{"id": 11, "languages_code": "es-ES", "display_name": "Sólo alojamiento"}],
+				"delete": [12]
+			}
+		}`, string(body))
+		fmt.Fprint(w, `{"data": {"id": 1, "code": "foo", "translations": [
+			{"id": 10, "languages_code": "en-US", "display_name": "Room only"},
+			{"id": 11, "languages_code": "es-ES", "display_name": "Sólo alojamiento"},
+			{"id": 13, "languages_code": "ca-ES", "display_name": "Només allotjament"}
+		]}}`)
+	}))
+	defer s.Close()
+	items := NewItemsClient[RegimeLocalized](NewClient(s.URL, "local-token"), "regimes")
+
+	updated, err := items.Update(context.Background(), "1", &regime)
+	require.NoError(t, err)
+	require.Equal(t, []string{"en-US", "es-ES", "ca-ES"}, updated.Translations.Languages())
+
+	b, err := json.Marshal(updated.Translations)
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(b))
+}
+
+func TestTranslationsPaths(t *testing.T) {
+	_, err := Path[RegimeLocalized]("translations.display_name")
+	require.NoError(t, err)
+
+	_, err = Path[RegimeLocalized]("translations.unknown")
+	require.Error(t, err)
+
+	require.Equal(t, []string{
+		"id",
+		"code",
+		"translations.id",
+		"translations.languages_code",
+		"translations.display_name",
+	}, autoFields(reflect.TypeFor[RegimeLocalized](), "", nil))
+}